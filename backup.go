@@ -0,0 +1,150 @@
+package folderfort
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+)
+
+// BackupManifestName is the name of the manifest written to the top of the
+// destination directory by BackupAll.
+const BackupManifestName = ".folderfort-backup.json"
+
+// BackupOptions configures BackupAll.
+type BackupOptions struct {
+	// Concurrency is the number of files downloaded in parallel. The default is 1.
+	Concurrency int
+
+	// SkipUnchanged skips files whose local copy already has the same size
+	// and modification time as the remote entry.
+	SkipUnchanged bool
+}
+
+// BackupManifest records every entry saved by BackupAll.
+type BackupManifest struct {
+	CreatedAt time.Time       `json:"created_at"`
+	Entries   []ManifestEntry `json:"entries"`
+}
+
+// ManifestEntry describes a single file or folder in a BackupManifest.
+type ManifestEntry struct {
+	// Path is relative to the backup directory and uses forward slashes.
+	Path      string        `json:"path"`
+	ID        int64         `json:"id"`
+	Type      FileEntryType `json:"type"`
+	Size      int64         `json:"size"`
+	UpdatedAt time.Time     `json:"updated_at"`
+}
+
+// BackupAll downloads every file and folder in the account into destDir,
+// preserving the folder structure. Each downloaded file is verified against
+// the size reported by the server and given the remote modification time.
+// A BackupManifestName manifest listing all entries is written to destDir.
+// A nil opts uses the defaults.
+// The returned Stats are populated even when an error is returned part way through.
+func (c *Client) BackupAll(ctx context.Context, destDir string, opts *BackupOptions) (*Stats, error) {
+	if opts == nil {
+		opts = &BackupOptions{}
+	}
+
+	stats := &Stats{IDs: map[string]int64{}}
+	manifest := &BackupManifest{CreatedAt: time.Now().UTC()}
+	var mu sync.Mutex // guards stats
+
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(max(opts.Concurrency, 1))
+
+	walkErr := c.WalkRemote(gctx, nil, func(relPath string, e Entry) error {
+		// Folders are visited before their contents, so checking each name
+		// keeps every path inside destDir.
+		if err := checkSafeName(e); err != nil {
+			return err
+		}
+		manifest.Entries = append(manifest.Entries, ManifestEntry{Path: relPath, ID: e.ID, Type: e.Type, Size: e.Size, UpdatedAt: e.UpdatedAt})
+		localPath := filepath.Join(destDir, filepath.FromSlash(relPath))
+
+		if e.IsFolder() {
+			if err := os.MkdirAll(localPath, 0755); err != nil {
+				return fmt.Errorf("error creating directory %v: %w", localPath, err)
+			}
+			mu.Lock()
+			stats.IDs[relPath] = e.ID
+			mu.Unlock()
+			return nil
+		}
+
+		if opts.SkipUnchanged && unchangedLocally(localPath, e) {
+			mu.Lock()
+			stats.skip(relPath, SkippedUnchanged)
+			mu.Unlock()
+			return nil
+		}
+
+		g.Go(func() error {
+			if err := c.backupFile(gctx, e, localPath); err != nil {
+				return err
+			}
+			mu.Lock()
+			stats.IDs[relPath] = e.ID
+			mu.Unlock()
+			return nil
+		})
+		return nil
+	})
+
+	if err := g.Wait(); err != nil {
+		return stats, err
+	}
+	if walkErr != nil {
+		return stats, walkErr
+	}
+
+	buf, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return stats, fmt.Errorf("failed to marshal manifest: %w", err)
+	}
+	if err := os.WriteFile(filepath.Join(destDir, BackupManifestName), buf, 0644); err != nil {
+		return stats, fmt.Errorf("failed to write manifest: %w", err)
+	}
+
+	return stats, nil
+}
+
+// backupFile downloads the file e to localPath, verifies its size and sets
+// its modification time to match the remote entry.
+func (c *Client) backupFile(ctx context.Context, e Entry, localPath string) error {
+	if err := c.DownloadToPath(ctx, e.ID, localPath); err != nil {
+		return err
+	}
+
+	info, err := os.Stat(localPath)
+	if err != nil {
+		return err
+	}
+	if e.Size > 0 && info.Size() != e.Size {
+		return fmt.Errorf("downloaded %v is %v bytes but expected %v", localPath, info.Size(), e.Size)
+	}
+
+	if !e.UpdatedAt.IsZero() {
+		if err := os.Chtimes(localPath, e.UpdatedAt, e.UpdatedAt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// unchangedLocally reports whether localPath already matches the remote
+// entry's size and modification time.
+func unchangedLocally(localPath string, e Entry) bool {
+	info, err := os.Stat(localPath)
+	if err != nil || e.UpdatedAt.IsZero() {
+		return false
+	}
+	return info.Size() == e.Size && info.ModTime().Equal(e.UpdatedAt)
+}
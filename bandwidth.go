@@ -0,0 +1,71 @@
+package folderfort
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	"golang.org/x/time/rate"
+)
+
+// maxBandwidthBurst caps the bytes sent at once under WithUploadBandwidthLimit,
+// keeping the upload rate smooth rather than bursty.
+const maxBandwidthBurst = 64 * 1024
+
+// WithUploadBandwidthLimit limits the request bodies of file uploads
+// (including chunked uploads) to bytesPerSec bytes per second on average,
+// so that background transfers do not saturate a shared connection. The
+// limit is applied as the body is written to the network and is shared by
+// all uploads of the client, so with WithConcurrency it bounds their total
+// rate. The default is no limit.
+func WithUploadBandwidthLimit(bytesPerSec int64) ClientOption {
+	return withDoer(func(d *doerWithToken) error {
+		if bytesPerSec <= 0 {
+			return fmt.Errorf("bandwidth limit must be positive, got %v", bytesPerSec)
+		}
+		d.bandwidth = rate.NewLimiter(rate.Limit(bytesPerSec), int(min(bytesPerSec, maxBandwidthBurst)))
+		return nil
+	})
+}
+
+// bandwidthEditor returns a RequestEditorFn that throttles sending the
+// request body with limiter.
+func bandwidthEditor(limiter *rate.Limiter) RequestEditorFn {
+	return func(ctx context.Context, req *http.Request) error {
+		if req.Body == nil || req.Body == http.NoBody {
+			return nil
+		}
+		req.Body = &throttledReader{ReadCloser: req.Body, ctx: ctx, limiter: limiter}
+		if getBody := req.GetBody; getBody != nil {
+			req.GetBody = func() (io.ReadCloser, error) {
+				body, err := getBody()
+				if err != nil {
+					return nil, err
+				}
+				return &throttledReader{ReadCloser: body, ctx: ctx, limiter: limiter}, nil
+			}
+		}
+		return nil
+	}
+}
+
+// throttledReader waits for limiter to allow the bytes returned by every Read.
+type throttledReader struct {
+	io.ReadCloser
+	ctx     context.Context
+	limiter *rate.Limiter
+}
+
+func (r *throttledReader) Read(p []byte) (int, error) {
+	if burst := r.limiter.Burst(); len(p) > burst {
+		p = p[:burst]
+	}
+	n, err := r.ReadCloser.Read(p)
+	if n > 0 {
+		if werr := r.limiter.WaitN(r.ctx, n); werr != nil {
+			return n, werr
+		}
+	}
+	return n, err
+}
@@ -0,0 +1,114 @@
+package folderfort
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"golang.org/x/sync/errgroup"
+)
+
+// UploadSpec describes one file uploaded by BatchUploadFiles.
+type UploadSpec struct {
+	// LocalPath is the local file to upload. It is ignored if Reader is set.
+	LocalPath string
+
+	// Reader, if not nil, supplies the file's content instead of LocalPath,
+	// in which case Name must be set.
+	Reader io.Reader
+
+	// Name is the name of the uploaded file. It defaults to the base name of LocalPath.
+	Name string
+
+	// ParentID is the folder that FolderPath is relative to, or nil for the root folder.
+	ParentID *int64
+
+	// FolderPath is the slash-separated path of the destination folder below
+	// ParentID, such as "photos/2024". Missing folders are created.
+	// If empty, the file is uploaded directly into ParentID.
+	FolderPath string
+
+	// MIMEType is the content type of the file. If empty, it is guessed as
+	// described for UploadFileFromPath.
+	MIMEType string
+
+	// Overwrite deletes any existing file of the same name in the destination folder.
+	Overwrite bool
+}
+
+// BatchUploadResult is the outcome of one UploadSpec passed to BatchUploadFiles.
+type BatchUploadResult struct {
+	// Spec is the requested upload.
+	Spec UploadSpec
+	// Result describes the new entry, or is nil if the upload failed.
+	Result *UploadResult
+	// Err is the reason the upload failed, or nil.
+	Err error
+}
+
+// BatchUploadFiles uploads an explicit list of files, each to its own
+// destination folder. The destination folders are resolved (and created if
+// needed) one at a time using the folder cache of GetOrCreateFolder, then
+// the files are uploaded in parallel as set by WithConcurrency.
+// A failed upload does not stop the others. The returned results are in the
+// same order as uploads, and the error joins every failure.
+func (c *Client) BatchUploadFiles(ctx context.Context, uploads []UploadSpec) ([]BatchUploadResult, error) {
+	results := make([]BatchUploadResult, len(uploads))
+	folderIDs := make([]*int64, len(uploads))
+	for i, spec := range uploads {
+		results[i].Spec = spec
+		if spec.Reader == nil && spec.LocalPath == "" {
+			results[i].Err = errors.New("upload spec needs a LocalPath or a Reader")
+			continue
+		}
+		if spec.Reader != nil && spec.Name == "" {
+			results[i].Err = errors.New("upload spec with a Reader needs a Name")
+			continue
+		}
+
+		folderIDs[i] = spec.ParentID
+		if spec.FolderPath == "" {
+			continue
+		}
+		id, err := c.GetOrCreateFolder(ctx, spec.FolderPath, spec.ParentID)
+		if err != nil {
+			results[i].Err = fmt.Errorf("unable to create folder %q: %w", spec.FolderPath, err)
+			continue
+		}
+		folderIDs[i] = id
+	}
+
+	var g errgroup.Group
+	g.SetLimit(c.doer().concurrency)
+	for i, spec := range uploads {
+		if results[i].Err != nil {
+			continue
+		}
+		g.Go(func() error {
+			if err := ctx.Err(); err != nil {
+				results[i].Err = err
+				return nil
+			}
+			results[i].Result, results[i].Err = c.batchUpload(ctx, spec, folderIDs[i])
+			return nil
+		})
+	}
+	g.Wait()
+
+	var errs []error
+	for _, r := range results {
+		if r.Err != nil {
+			errs = append(errs, r.Err)
+		}
+	}
+	return results, errors.Join(errs...)
+}
+
+// batchUpload uploads the file described by spec into the folder parentID.
+func (c *Client) batchUpload(ctx context.Context, spec UploadSpec, parentID *int64) (*UploadResult, error) {
+	if spec.Reader != nil {
+		return c.uploadFile(ctx, spec.Name, spec.Reader, spec.MIMEType, parentID, spec.Overwrite)
+	}
+	return c.uploadFileFromPath(ctx, nil, spec.LocalPath, spec.Name, spec.MIMEType, parentID, spec.Overwrite, nil)
+}
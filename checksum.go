@@ -0,0 +1,38 @@
+package folderfort
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrChecksumMismatch is returned by UploadFile when WithChecksumVerification
+// is enabled and the checksum reported by the server differs from the
+// checksum of the bytes that were sent.
+var ErrChecksumMismatch = errors.New("uploaded file checksum mismatch")
+
+// verifyChecksum compares the local SHA-256 of fileName with the one reported
+// by the server. A server that reports no checksum only produces a warning.
+func (c *Client) verifyChecksum(fileName, local, remote string) error {
+	if remote == "" {
+		c.doer().logf("warning: server reported no checksum for %q; skipping verification", fileName)
+		return nil
+	}
+	if !strings.EqualFold(local, remote) {
+		return fmt.Errorf("%w: %q sent as sha256 %v but server reports %v", ErrChecksumMismatch, fileName, local, remote)
+	}
+	return nil
+}
+
+// WithChecksumVerification computes the SHA-256 checksum of every file as it
+// is uploaded and compares it with the checksum reported in the server's
+// response, returning ErrChecksumMismatch if they differ. If the server does
+// not report a checksum, a warning is logged and the upload succeeds.
+// The computed checksum is available from UploadFileWithResult.
+// The default is off.
+func WithChecksumVerification(enabled bool) ClientOption {
+	return withDoer(func(d *doerWithToken) error {
+		d.verifyChecksum = enabled
+		return nil
+	})
+}
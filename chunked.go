@@ -0,0 +1,374 @@
+package folderfort
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultChunkSize is the number of bytes UploadFileChunked sends per request
+// when ChunkedUploadOptions.ChunkSize is not set.
+const DefaultChunkSize = 8 * 1024 * 1024
+
+// tusVersion is the version of the tus resumable upload protocol spoken by the API.
+const tusVersion = "1.0.0"
+
+// maxChunkConflicts is how many times in a row UploadFileChunked asks the
+// server for the upload's offset after a 409 before giving up.
+const maxChunkConflicts = 3
+
+// ChunkedUploadOptions configures UploadFileChunked.
+type ChunkedUploadOptions struct {
+	// ChunkSize is the number of bytes sent per request.
+	// The default is DefaultChunkSize.
+	ChunkSize int64
+
+	// StateFile, if not empty, is the local path of a JSON file where the
+	// upload's key and progress are saved after every chunk. If a previous
+	// call for the same unmodified file was interrupted, the upload resumes
+	// from the last offset acknowledged by the server instead of restarting.
+	// The file is removed once the upload completes.
+	StateFile string
+}
+
+// chunkedUploadState is the content of ChunkedUploadOptions.StateFile.
+type chunkedUploadState struct {
+	Path      string    `json:"path"`
+	Size      int64     `json:"size"`
+	ModTime   time.Time `json:"mod_time"`
+	ParentID  *int64    `json:"parent_id,omitempty"`
+	UploadKey string    `json:"upload_key"`
+	Offset    int64     `json:"offset"`
+}
+
+// matches reports whether s describes an upload of the local file filePath,
+// described by info, into parentID.
+func (s *chunkedUploadState) matches(filePath string, info fs.FileInfo, parentID *int64) bool {
+	return s.UploadKey != "" && s.Path == filePath && s.Size == info.Size() &&
+		s.ModTime.Equal(info.ModTime()) && sameParent(s.ParentID, parentID)
+}
+
+func readChunkedUploadState(filename string) (*chunkedUploadState, error) {
+	buf, err := os.ReadFile(filename)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var state chunkedUploadState
+	if err := json.Unmarshal(buf, &state); err != nil {
+		return nil, fmt.Errorf("failed to parse upload state file %v: %w", filename, err)
+	}
+	return &state, nil
+}
+
+func writeChunkedUploadState(filename string, state *chunkedUploadState) error {
+	if filename == "" {
+		return nil
+	}
+	buf, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal upload state: %w", err)
+	}
+	if err := os.WriteFile(filename, buf, 0644); err != nil {
+		return fmt.Errorf("failed to write upload state file: %w", err)
+	}
+	return nil
+}
+
+// UploadFileChunked uploads the local file filePath into the folder parentID
+// (or the root folder if nil) in chunks, using the API's resumable (tus)
+// upload endpoints. It is meant for very large files on unreliable
+// connections: a chunk that fails with a network error or a 429 or 5xx
+// response is sent again from the offset the server reports, up to the
+// number of retries set by WithRetry, and with ChunkedUploadOptions.StateFile,
+// a later call resumes an upload interrupted some other way. Existing files of the same name are left alone.
+// A nil opts uses the defaults.
+func (c *Client) UploadFileChunked(ctx context.Context, filePath string, parentID *int64, opts *ChunkedUploadOptions) (*UploadResult, error) {
+	if opts == nil {
+		opts = &ChunkedUploadOptions{}
+	}
+	chunkSize := opts.ChunkSize
+	if chunkSize <= 0 {
+		chunkSize = DefaultChunkSize
+	}
+
+	file, err := os.Open(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("error opening file %v: %w", filePath, err)
+	}
+	defer file.Close()
+	info, err := file.Stat()
+	if err != nil {
+		return nil, fmt.Errorf("error getting file info for %v: %w", filePath, err)
+	}
+	size := info.Size()
+
+	var state *chunkedUploadState
+	if opts.StateFile != "" {
+		if state, err = readChunkedUploadState(opts.StateFile); err != nil {
+			return nil, err
+		}
+	}
+
+	var offset int64
+	if state != nil && state.matches(filePath, info, parentID) {
+		offset, err = c.tusOffset(ctx, state.UploadKey)
+		if err != nil {
+			var apiErr *APIError
+			if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusNotFound {
+				return nil, err
+			}
+			c.doer().logf("UploadFileChunked: upload %v of %v expired; starting over", state.UploadKey, filePath)
+			state, offset = nil, 0
+		}
+	} else {
+		state = nil
+	}
+
+	if state == nil {
+		key, err := c.tusCreate(ctx, filePath, size, parentID)
+		if err != nil {
+			return nil, err
+		}
+		state = &chunkedUploadState{Path: filePath, Size: size, ModTime: info.ModTime(), ParentID: parentID, UploadKey: key}
+	} else {
+		c.doer().logf("UploadFileChunked: resuming upload %v of %v at offset %v", state.UploadKey, filePath, offset)
+	}
+
+	buf := make([]byte, min(chunkSize, max(size, 1)))
+	var failures, conflicts int // consecutive failed and conflicting chunks
+	for offset < size {
+		if err := ctx.Err(); err != nil {
+			return nil, fmt.Errorf("upload of %v stopped: %w", filePath, err)
+		}
+		state.Offset = offset
+		if err := writeChunkedUploadState(opts.StateFile, state); err != nil {
+			return nil, err
+		}
+
+		n, err := file.ReadAt(buf[:min(int64(len(buf)), size-offset)], offset)
+		if err != nil && err != io.EOF {
+			return nil, fmt.Errorf("error reading file %v: %w", filePath, err)
+		}
+		if n == 0 {
+			return nil, fmt.Errorf("file %v shrank during upload", filePath)
+		}
+
+		newOffset, err := c.tusPatch(ctx, state.UploadKey, offset, buf[:n])
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, fmt.Errorf("upload of %v stopped: %w", filePath, err)
+			}
+			// A conflict means the server has a different idea of where
+			// the upload stands, for example because a previous response
+			// was lost, so it is simply asked below. Other failures are
+			// retried after a pause.
+			var apiErr *APIError
+			if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusConflict {
+				if conflicts >= maxChunkConflicts {
+					return nil, fmt.Errorf("upload %v of %v gave up after %v conflicts: %w", state.UploadKey, filePath, conflicts, err)
+				}
+				conflicts++
+			} else {
+				if failures >= c.doer().maxRetries || !retryableChunkError(err) {
+					return nil, err
+				}
+				wait := c.doer().retryDelay(failures, nil)
+				failures++
+				c.doer().logf("UploadFileChunked: retrying upload %v of %v after %v: %v", state.UploadKey, filePath, wait, err)
+				if err := sleepContext(ctx, wait); err != nil {
+					return nil, fmt.Errorf("upload of %v stopped: %w", filePath, err)
+				}
+			}
+			// Part of the chunk may have arrived, so resume from wherever the server says.
+			if newOffset, err = c.tusOffset(ctx, state.UploadKey); err != nil {
+				return nil, err
+			}
+			c.doer().logf("UploadFileChunked: resynchronized upload %v of %v at offset %v", state.UploadKey, filePath, newOffset)
+			offset = newOffset
+			continue
+		}
+		failures, conflicts = 0, 0
+		offset = newOffset
+		if fn := c.doer().progress; fn != nil {
+			fn(offset, size)
+		}
+	}
+
+	state.Offset = offset
+	if err := writeChunkedUploadState(opts.StateFile, state); err != nil {
+		return nil, err
+	}
+
+	result, err := c.tusEntry(ctx, state.UploadKey, filePath)
+	if err != nil {
+		return nil, err
+	}
+	if opts.StateFile != "" {
+		if err := os.Remove(opts.StateFile); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			c.doer().logf("UploadFileChunked: os.Remove(%v): %v (ignoring)", opts.StateFile, err)
+		}
+	}
+	return result, nil
+}
+
+// retryableChunkError reports whether a chunk that failed with err may be
+// sent again: after a network error, or a server error that may succeed
+// later. A 429 or 503 has already been retried by the client's transport
+// (see WithRetry), so it is not retried a second time here.
+func retryableChunkError(err error) bool {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return true
+	}
+	switch apiErr.StatusCode {
+	case http.StatusTooManyRequests, http.StatusServiceUnavailable:
+		return false
+	}
+	return apiErr.StatusCode >= 500
+}
+
+// tusMetadata encodes pairs as an Upload-Metadata header value.
+func tusMetadata(pairs ...string) string {
+	var fields []string
+	for i := 0; i+1 < len(pairs); i += 2 {
+		fields = append(fields, pairs[i]+" "+base64.StdEncoding.EncodeToString([]byte(pairs[i+1])))
+	}
+	return strings.Join(fields, ",")
+}
+
+// tusCreate starts a resumable upload of size bytes for the local file
+// filePath and returns its upload key.
+func (c *Client) tusCreate(ctx context.Context, filePath string, size int64, parentID *int64) (string, error) {
+	pairs := []string{"name", filepath.Base(filePath), "mime", c.doer().mimeTypeByExtension(filePath)}
+	if parentID != nil {
+		pairs = append(pairs, "parentId", fmt.Sprintf("%v", *parentID))
+	}
+	if ws := c.doer().workspaceID; ws != nil {
+		pairs = append(pairs, "workspaceId", fmt.Sprintf("%v", *ws))
+	}
+
+	resp, err := c.TusCreate(ctx, &TusCreateParams{
+		TusResumable:   tusVersion,
+		UploadLength:   size,
+		UploadMetadata: Ptr(tusMetadata(pairs...)),
+	})
+	if err != nil {
+		return "", fmt.Errorf("c.TusCreate: %w", err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("io.ReadAll: %w", err)
+	}
+
+	if resp.StatusCode != 201 {
+		return "", fmt.Errorf("failed to start upload of %v: %w", filePath, newAPIError(resp.StatusCode, body))
+	}
+	location := strings.TrimSuffix(resp.Header.Get("Location"), "/")
+	if location == "" {
+		return "", fmt.Errorf("failed to start upload of %v: no Location in response", filePath)
+	}
+	return path.Base(location), nil
+}
+
+// tusOffset returns the number of bytes of the upload uploadKey received by the server.
+func (c *Client) tusOffset(ctx context.Context, uploadKey string) (int64, error) {
+	resp, err := c.TusOffset(ctx, uploadKey, &TusOffsetParams{TusResumable: tusVersion})
+	if err != nil {
+		return 0, fmt.Errorf("c.TusOffset: %w", err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return 0, fmt.Errorf("io.ReadAll: %w", err)
+	}
+
+	if resp.StatusCode != 200 && resp.StatusCode != 204 {
+		return 0, fmt.Errorf("failed to get offset of upload %v: %w", uploadKey, newAPIError(resp.StatusCode, body))
+	}
+	return parseUploadOffset(resp, uploadKey)
+}
+
+// tusPatch sends chunk, which starts at offset, to the upload uploadKey and
+// returns the upload's new offset.
+func (c *Client) tusPatch(ctx context.Context, uploadKey string, offset int64, chunk []byte) (int64, error) {
+	params := &TusPatchParams{TusResumable: tusVersion, UploadOffset: offset}
+	var editors []RequestEditorFn
+	if limiter := c.doer().bandwidth; limiter != nil {
+		editors = append(editors, bandwidthEditor(limiter))
+	}
+	resp, err := c.TusPatchWithBody(ctx, uploadKey, params, "application/offset+octet-stream", bytes.NewReader(chunk), editors...)
+	if err != nil {
+		return 0, fmt.Errorf("c.TusPatchWithBody: %w", err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return 0, fmt.Errorf("io.ReadAll: %w", err)
+	}
+
+	if resp.StatusCode != 200 && resp.StatusCode != 204 {
+		return 0, fmt.Errorf("failed to upload chunk at offset %v of upload %v: %w", offset, uploadKey, newAPIError(resp.StatusCode, body))
+	}
+	if resp.Header.Get("Upload-Offset") == "" {
+		return offset + int64(len(chunk)), nil
+	}
+	return parseUploadOffset(resp, uploadKey)
+}
+
+func parseUploadOffset(resp *http.Response, uploadKey string) (int64, error) {
+	offset, err := strconv.ParseInt(resp.Header.Get("Upload-Offset"), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid Upload-Offset for upload %v: %w", uploadKey, err)
+	}
+	return offset, nil
+}
+
+// tusEntry creates the file entry for the completed upload uploadKey of the
+// local file filePath.
+func (c *Client) tusEntry(ctx context.Context, uploadKey, filePath string) (*UploadResult, error) {
+	resp, err := c.TusEntry(ctx, TusEntryJSONRequestBody{UploadKey: &uploadKey})
+	if err != nil {
+		return nil, fmt.Errorf("c.TusEntry: %w", err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("io.ReadAll: %w", err)
+	}
+
+	if resp.StatusCode != 200 && resp.StatusCode != 201 {
+		return nil, fmt.Errorf("failed to create entry for upload of %v: %w", filePath, newAPIError(resp.StatusCode, body))
+	}
+	if err := checkEnvelope(resp.StatusCode, body); err != nil {
+		return nil, err
+	}
+
+	var uploadResp uploadWithBodyResponse
+	if err := json.Unmarshal(body, &uploadResp); err != nil {
+		return nil, fmt.Errorf("failed to parse response for file '%v': %w\n%s", filePath, err, body)
+	}
+	entry := uploadResp.FileEntry.Entry
+	return &UploadResult{
+		ID:   entry.ID,
+		Name: entry.Name,
+		Size: entry.Size,
+		MIME: entry.MIME,
+		URL:  entry.URL,
+	}, nil
+}
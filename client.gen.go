@@ -31,6 +31,20 @@ const (
 	FileEntryTypeVideo  FileEntryType = "video"
 )
 
+// Defines values for IndexEntryParamsOrderBy.
+const (
+	OrderByCreatedAt IndexEntryParamsOrderBy = "created_at"
+	OrderByFileSize  IndexEntryParamsOrderBy = "file_size"
+	OrderByName      IndexEntryParamsOrderBy = "name"
+	OrderByUpdatedAt IndexEntryParamsOrderBy = "updated_at"
+)
+
+// Defines values for IndexEntryParamsOrderDir.
+const (
+	OrderAscending  IndexEntryParamsOrderDir = "asc"
+	OrderDescending IndexEntryParamsOrderDir = "desc"
+)
+
 // Defines values for IndexEntryParamsType.
 const (
 	IndexEntryParamsTypeAudio  IndexEntryParamsType = "audio"
@@ -91,6 +105,9 @@ type FileEntry struct {
 	// Path full path of parent folder IDs for this entry up to root
 	Path *string `json:"path,omitempty"`
 
+	// ProcessingStatus Processing state of a newly uploaded file (e.g. `processing`, `ready` or `failed`), or absent if the file needs no processing
+	ProcessingStatus *string `json:"processing_status,omitempty"`
+
 	// Thumbnail Relative path to thumbnail image for the file (if it exists)
 	Thumbnail *string        `json:"thumbnail,omitempty"`
 	Type      *FileEntryType `json:"type,omitempty"`
@@ -181,6 +198,15 @@ type IndexEntryParams struct {
 	// PerPage How many entries to return per page
 	PerPage *int64 `form:"perPage,omitempty" json:"perPage,omitempty"`
 
+	// Page Which page of entries to return
+	Page *int64 `form:"page,omitempty" json:"page,omitempty"`
+
+	// OrderBy Entry property results should be sorted by
+	OrderBy *IndexEntryParamsOrderBy `form:"orderBy,omitempty" json:"orderBy,omitempty"`
+
+	// OrderDir Direction results should be sorted in
+	OrderDir *IndexEntryParamsOrderDir `form:"orderDir,omitempty" json:"orderDir,omitempty"`
+
 	// DeletedOnly Whether only trashed entries should be returned
 	DeletedOnly *bool `form:"deletedOnly,omitempty" json:"deletedOnly,omitempty"`
 
@@ -206,6 +232,12 @@ type IndexEntryParams struct {
 	WorkspaceId *int `form:"workspaceId,omitempty" json:"workspaceId,omitempty"`
 }
 
+// IndexEntryParamsOrderBy defines parameters for IndexEntry.
+type IndexEntryParamsOrderBy string
+
+// IndexEntryParamsOrderDir defines parameters for IndexEntry.
+type IndexEntryParamsOrderDir string
+
 // IndexEntryParamsType defines parameters for IndexEntry.
 type IndexEntryParamsType string
 
@@ -267,6 +299,18 @@ type PutFileEntriesEntryIdChangePermissionsJSONBody struct {
 // PutFileEntriesEntryIdChangePermissionsJSONBodyPermissions defines parameters for PutFileEntriesEntryIdChangePermissions.
 type PutFileEntriesEntryIdChangePermissionsJSONBodyPermissions string
 
+// UpdateEntryContentMultipartBody defines parameters for UpdateEntryContent.
+type UpdateEntryContentMultipartBody struct {
+	// File New content of the file
+	File *openapi_types.File `json:"file,omitempty"`
+}
+
+// DownloadEntryParams defines parameters for DownloadEntry.
+type DownloadEntryParams struct {
+	// Range Optional byte range to download, e.g. `bytes=0-1023`
+	Range *string `json:"Range,omitempty"`
+}
+
 // PostFileEntriesEntryIdShareJSONBody defines parameters for PostFileEntriesEntryIdShare.
 type PostFileEntriesEntryIdShareJSONBody struct {
 	Emails      *[]string                                         `json:"emails,omitempty"`
@@ -307,6 +351,50 @@ type CreateFolderJSONBody struct {
 
 	// ParentId ID of parent folder or null if it should be created at root
 	ParentId *int `json:"parentId,omitempty"`
+
+	// WorkspaceId ID of workspace the folder should be created in, or null for the personal space
+	WorkspaceId *int `json:"workspaceId,omitempty"`
+}
+
+// ShowShareableLinkParams defines parameters for ShowShareableLink.
+type ShowShareableLinkParams struct {
+	// Password Password for the link, if it is password protected
+	Password *string `form:"password,omitempty" json:"password,omitempty"`
+}
+
+// DownloadShareableLinkParams defines parameters for DownloadShareableLink.
+type DownloadShareableLinkParams struct {
+	// Password Password for the link, if it is password protected
+	Password *string `form:"password,omitempty" json:"password,omitempty"`
+}
+
+// TusEntryJSONBody defines parameters for TusEntry.
+type TusEntryJSONBody struct {
+	UploadKey *string `json:"uploadKey,omitempty"`
+}
+
+// TusCreateParams defines parameters for TusCreate.
+type TusCreateParams struct {
+	TusResumable string `json:"Tus-Resumable"`
+
+	// UploadLength Total size of the file in bytes
+	UploadLength int64 `json:"Upload-Length"`
+
+	// UploadMetadata Comma-separated `key base64(value)` pairs: `name`, `mime`, `parentId` and `workspaceId`
+	UploadMetadata *string `json:"Upload-Metadata,omitempty"`
+}
+
+// TusOffsetParams defines parameters for TusOffset.
+type TusOffsetParams struct {
+	TusResumable string `json:"Tus-Resumable"`
+}
+
+// TusPatchParams defines parameters for TusPatch.
+type TusPatchParams struct {
+	TusResumable string `json:"Tus-Resumable"`
+
+	// UploadOffset Offset of this chunk, which must equal the current offset of the upload
+	UploadOffset int64 `json:"Upload-Offset"`
 }
 
 // UploadMultipartBody defines parameters for Upload.
@@ -314,11 +402,17 @@ type UploadMultipartBody struct {
 	// File Content of file to upload to SITE_NAME
 	File *openapi_types.File `json:"file,omitempty"`
 
+	// LastModified Modification time of the original file in milliseconds since the Unix epoch. Servers that do not support it ignore it.
+	LastModified *int64 `json:"lastModified,omitempty"`
+
 	// ParentId ID of folder where this file should be uploaded, `null` will upload to root
 	ParentId *int `json:"parentId,omitempty"`
 
 	// RelativePath Folders in the path provided here will be auto created, if they don't exist already. This is mainly useful when uploading a folder from browser. It should include original filename as well: <br> `/some/folders/here/file-name.jpg`
 	RelativePath *string `json:"relativePath,omitempty"`
+
+	// WorkspaceId ID of workspace the file should be uploaded to, `null` will upload to the personal space
+	WorkspaceId *int `json:"workspaceId,omitempty"`
 }
 
 // LoginJSONRequestBody defines body for Login for application/json ContentType.
@@ -351,6 +445,9 @@ type EntryUpdateJSONRequestBody EntryUpdateJSONBody
 // PutFileEntriesEntryIdChangePermissionsJSONRequestBody defines body for PutFileEntriesEntryIdChangePermissions for application/json ContentType.
 type PutFileEntriesEntryIdChangePermissionsJSONRequestBody PutFileEntriesEntryIdChangePermissionsJSONBody
 
+// UpdateEntryContentMultipartRequestBody defines body for UpdateEntryContent for multipart/form-data ContentType.
+type UpdateEntryContentMultipartRequestBody UpdateEntryContentMultipartBody
+
 // PostFileEntriesEntryIdShareJSONRequestBody defines body for PostFileEntriesEntryIdShare for application/json ContentType.
 type PostFileEntriesEntryIdShareJSONRequestBody PostFileEntriesEntryIdShareJSONBody
 
@@ -366,6 +463,9 @@ type UpdateShareableLinkJSONRequestBody UpdateShareableLinkJSONBody
 // CreateFolderJSONRequestBody defines body for CreateFolder for application/json ContentType.
 type CreateFolderJSONRequestBody CreateFolderJSONBody
 
+// TusEntryJSONRequestBody defines body for TusEntry for application/json ContentType.
+type TusEntryJSONRequestBody TusEntryJSONBody
+
 // UploadMultipartRequestBody defines body for Upload for multipart/form-data ContentType.
 type UploadMultipartRequestBody UploadMultipartBody
 
@@ -485,6 +585,9 @@ type ClientInterface interface {
 
 	PostFileEntriesUnstar(ctx context.Context, body PostFileEntriesUnstarJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ShowEntry request
+	ShowEntry(ctx context.Context, entryId int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// EntryUpdateWithBody request with any body
 	EntryUpdateWithBody(ctx context.Context, entryId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -495,6 +598,12 @@ type ClientInterface interface {
 
 	PutFileEntriesEntryIdChangePermissions(ctx context.Context, entryId int, body PutFileEntriesEntryIdChangePermissionsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// UpdateEntryContentWithBody request with any body
+	UpdateEntryContentWithBody(ctx context.Context, entryId int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DownloadEntry request
+	DownloadEntry(ctx context.Context, entryId int64, params *DownloadEntryParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// PostFileEntriesEntryIdShareWithBody request with any body
 	PostFileEntriesEntryIdShareWithBody(ctx context.Context, entryId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -526,8 +635,37 @@ type ClientInterface interface {
 
 	CreateFolder(ctx context.Context, body CreateFolderJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ShowShareableLink request
+	ShowShareableLink(ctx context.Context, hash string, params *ShowShareableLinkParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DownloadShareableLink request
+	DownloadShareableLink(ctx context.Context, hash string, params *DownloadShareableLinkParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// TusEntryWithBody request with any body
+	TusEntryWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	TusEntry(ctx context.Context, body TusEntryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// TusCreate request
+	TusCreate(ctx context.Context, params *TusCreateParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// TusOffset request
+	TusOffset(ctx context.Context, uploadKey string, params *TusOffsetParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// TusPatchWithBody request with any body
+	TusPatchWithBody(ctx context.Context, uploadKey string, params *TusPatchParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// UploadWithBody request with any body
 	UploadWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UploadConfig request
+	UploadConfig(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CurrentUser request
+	CurrentUser(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SpaceUsage request
+	SpaceUsage(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
 
 func (c *Client) LoginWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -734,6 +872,18 @@ func (c *Client) PostFileEntriesUnstar(ctx context.Context, body PostFileEntries
 	return c.Client.Do(req)
 }
 
+func (c *Client) ShowEntry(ctx context.Context, entryId int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewShowEntryRequest(c.Server, entryId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) EntryUpdateWithBody(ctx context.Context, entryId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewEntryUpdateRequestWithBody(c.Server, entryId, contentType, body)
 	if err != nil {
@@ -782,6 +932,30 @@ func (c *Client) PutFileEntriesEntryIdChangePermissions(ctx context.Context, ent
 	return c.Client.Do(req)
 }
 
+func (c *Client) UpdateEntryContentWithBody(ctx context.Context, entryId int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateEntryContentRequestWithBody(c.Server, entryId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DownloadEntry(ctx context.Context, entryId int64, params *DownloadEntryParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDownloadEntryRequest(c.Server, entryId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) PostFileEntriesEntryIdShareWithBody(ctx context.Context, entryId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewPostFileEntriesEntryIdShareRequestWithBody(c.Server, entryId, contentType, body)
 	if err != nil {
@@ -926,6 +1100,90 @@ func (c *Client) CreateFolder(ctx context.Context, body CreateFolderJSONRequestB
 	return c.Client.Do(req)
 }
 
+func (c *Client) ShowShareableLink(ctx context.Context, hash string, params *ShowShareableLinkParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewShowShareableLinkRequest(c.Server, hash, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DownloadShareableLink(ctx context.Context, hash string, params *DownloadShareableLinkParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDownloadShareableLinkRequest(c.Server, hash, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TusEntryWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTusEntryRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TusEntry(ctx context.Context, body TusEntryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTusEntryRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TusCreate(ctx context.Context, params *TusCreateParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTusCreateRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TusOffset(ctx context.Context, uploadKey string, params *TusOffsetParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTusOffsetRequest(c.Server, uploadKey, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TusPatchWithBody(ctx context.Context, uploadKey string, params *TusPatchParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTusPatchRequestWithBody(c.Server, uploadKey, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) UploadWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewUploadRequestWithBody(c.Server, contentType, body)
 	if err != nil {
@@ -938,6 +1196,42 @@ func (c *Client) UploadWithBody(ctx context.Context, contentType string, body io
 	return c.Client.Do(req)
 }
 
+func (c *Client) UploadConfig(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUploadConfigRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CurrentUser(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCurrentUserRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SpaceUsage(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSpaceUsageRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 // NewLoginRequest calls the generic Login builder with application/json body
 func NewLoginRequest(server string, body LoginJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
@@ -1056,6 +1350,54 @@ func NewIndexEntryRequest(server string, params *IndexEntryParams) (*http.Reques
 
 		}
 
+		if params.Page != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page", runtime.ParamLocationQuery, *params.Page); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.OrderBy != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "orderBy", runtime.ParamLocationQuery, *params.OrderBy); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.OrderDir != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "orderDir", runtime.ParamLocationQuery, *params.OrderDir); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
 		if params.DeletedOnly != nil {
 
 			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "deletedOnly", runtime.ParamLocationQuery, *params.DeletedOnly); err != nil {
@@ -1437,6 +1779,40 @@ func NewPostFileEntriesUnstarRequestWithBody(server string, contentType string,
 	return req, nil
 }
 
+// NewShowEntryRequest generates requests for ShowEntry
+func NewShowEntryRequest(server string, entryId int64) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "entryId", runtime.ParamLocationPath, entryId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/file-entries/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
 // NewEntryUpdateRequest calls the generic EntryUpdate builder with application/json body
 func NewEntryUpdateRequest(server string, entryId int, body EntryUpdateJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
@@ -1531,8 +1907,93 @@ func NewPutFileEntriesEntryIdChangePermissionsRequestWithBody(server string, ent
 	return req, nil
 }
 
-// NewPostFileEntriesEntryIdShareRequest calls the generic PostFileEntriesEntryIdShare builder with application/json body
-func NewPostFileEntriesEntryIdShareRequest(server string, entryId int, body PostFileEntriesEntryIdShareJSONRequestBody) (*http.Request, error) {
+// NewUpdateEntryContentRequestWithBody generates requests for UpdateEntryContent with any type of body
+func NewUpdateEntryContentRequestWithBody(server string, entryId int64, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "entryId", runtime.ParamLocationPath, entryId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/file-entries/%s/content", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDownloadEntryRequest generates requests for DownloadEntry
+func NewDownloadEntryRequest(server string, entryId int64, params *DownloadEntryParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "entryId", runtime.ParamLocationPath, entryId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/file-entries/%s/download", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.Range != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Range", runtime.ParamLocationHeader, *params.Range)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Range", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewPostFileEntriesEntryIdShareRequest calls the generic PostFileEntriesEntryIdShare builder with application/json body
+func NewPostFileEntriesEntryIdShareRequest(server string, entryId int, body PostFileEntriesEntryIdShareJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
@@ -1827,16 +2288,23 @@ func NewCreateFolderRequestWithBody(server string, contentType string, body io.R
 	return req, nil
 }
 
-// NewUploadRequestWithBody generates requests for Upload with any type of body
-func NewUploadRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewShowShareableLinkRequest generates requests for ShowShareableLink
+func NewShowShareableLinkRequest(server string, hash string, params *ShowShareableLinkParams) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "hash", runtime.ParamLocationPath, hash)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/uploads")
+	operationPath := fmt.Sprintf("/shareable-links/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1846,159 +2314,764 @@ func NewUploadRequestWithBody(server string, contentType string, body io.Reader)
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
-		return nil, err
-	}
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	req.Header.Add("Content-Type", contentType)
+		if params.Password != nil {
 
-	return req, nil
-}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "password", runtime.ParamLocationQuery, *params.Password); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
 		}
+
+		queryURL.RawQuery = queryValues.Encode()
 	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return nil
-}
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
+	return req, nil
 }
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+// NewDownloadShareableLinkRequest generates requests for DownloadShareableLink
+func NewDownloadShareableLinkRequest(server string, hash string, params *DownloadShareableLinkParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "hash", runtime.ParamLocationPath, hash)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
-}
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-}
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// LoginWithBodyWithResponse request with any body
-	LoginWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LoginResponse, error)
+	operationPath := fmt.Sprintf("/shareable-links/%s/download", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	LoginWithResponse(ctx context.Context, body LoginJSONRequestBody, reqEditors ...RequestEditorFn) (*LoginResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// RegisterWithBodyWithResponse request with any body
-	RegisterWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RegisterResponse, error)
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	RegisterWithResponse(ctx context.Context, body RegisterJSONRequestBody, reqEditors ...RequestEditorFn) (*RegisterResponse, error)
+		if params.Password != nil {
 
-	// IndexEntryWithResponse request
-	IndexEntryWithResponse(ctx context.Context, params *IndexEntryParams, reqEditors ...RequestEditorFn) (*IndexEntryResponse, error)
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "password", runtime.ParamLocationQuery, *params.Password); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	// EntriesDeleteWithBodyWithResponse request with any body
-	EntriesDeleteWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EntriesDeleteResponse, error)
+		}
 
-	EntriesDeleteWithResponse(ctx context.Context, body EntriesDeleteJSONRequestBody, reqEditors ...RequestEditorFn) (*EntriesDeleteResponse, error)
+		queryURL.RawQuery = queryValues.Encode()
+	}
 
-	// EntriesCopyWithBodyWithResponse request with any body
-	EntriesCopyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EntriesCopyResponse, error)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	EntriesCopyWithResponse(ctx context.Context, body EntriesCopyJSONRequestBody, reqEditors ...RequestEditorFn) (*EntriesCopyResponse, error)
+	return req, nil
+}
 
-	// EntriesMoveWithBodyWithResponse request with any body
-	EntriesMoveWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EntriesMoveResponse, error)
+// NewTusEntryRequest calls the generic TusEntry builder with application/json body
+func NewTusEntryRequest(server string, body TusEntryJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewTusEntryRequestWithBody(server, "application/json", bodyReader)
+}
 
-	EntriesMoveWithResponse(ctx context.Context, body EntriesMoveJSONRequestBody, reqEditors ...RequestEditorFn) (*EntriesMoveResponse, error)
+// NewTusEntryRequestWithBody generates requests for TusEntry with any type of body
+func NewTusEntryRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	// EntriesRestoreWithBodyWithResponse request with any body
-	EntriesRestoreWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EntriesRestoreResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	EntriesRestoreWithResponse(ctx context.Context, body EntriesRestoreJSONRequestBody, reqEditors ...RequestEditorFn) (*EntriesRestoreResponse, error)
+	operationPath := fmt.Sprintf("/tus/entries")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// PostFileEntriesStarWithBodyWithResponse request with any body
-	PostFileEntriesStarWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostFileEntriesStarResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	PostFileEntriesStarWithResponse(ctx context.Context, body PostFileEntriesStarJSONRequestBody, reqEditors ...RequestEditorFn) (*PostFileEntriesStarResponse, error)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-	// PostFileEntriesUnstarWithBodyWithResponse request with any body
-	PostFileEntriesUnstarWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostFileEntriesUnstarResponse, error)
+	req.Header.Add("Content-Type", contentType)
 
-	PostFileEntriesUnstarWithResponse(ctx context.Context, body PostFileEntriesUnstarJSONRequestBody, reqEditors ...RequestEditorFn) (*PostFileEntriesUnstarResponse, error)
+	return req, nil
+}
 
-	// EntryUpdateWithBodyWithResponse request with any body
-	EntryUpdateWithBodyWithResponse(ctx context.Context, entryId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EntryUpdateResponse, error)
+// NewTusCreateRequest generates requests for TusCreate
+func NewTusCreateRequest(server string, params *TusCreateParams) (*http.Request, error) {
+	var err error
 
-	EntryUpdateWithResponse(ctx context.Context, entryId int, body EntryUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*EntryUpdateResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// PutFileEntriesEntryIdChangePermissionsWithBodyWithResponse request with any body
-	PutFileEntriesEntryIdChangePermissionsWithBodyWithResponse(ctx context.Context, entryId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutFileEntriesEntryIdChangePermissionsResponse, error)
+	operationPath := fmt.Sprintf("/tus/upload")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	PutFileEntriesEntryIdChangePermissionsWithResponse(ctx context.Context, entryId int, body PutFileEntriesEntryIdChangePermissionsJSONRequestBody, reqEditors ...RequestEditorFn) (*PutFileEntriesEntryIdChangePermissionsResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// PostFileEntriesEntryIdShareWithBodyWithResponse request with any body
-	PostFileEntriesEntryIdShareWithBodyWithResponse(ctx context.Context, entryId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostFileEntriesEntryIdShareResponse, error)
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	PostFileEntriesEntryIdShareWithResponse(ctx context.Context, entryId int, body PostFileEntriesEntryIdShareJSONRequestBody, reqEditors ...RequestEditorFn) (*PostFileEntriesEntryIdShareResponse, error)
+	if params != nil {
 
-	// GetShareableLinkWithResponse request
-	GetShareableLinkWithResponse(ctx context.Context, entryId int64, reqEditors ...RequestEditorFn) (*GetShareableLinkResponse, error)
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Tus-Resumable", runtime.ParamLocationHeader, params.TusResumable)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("Tus-Resumable", headerParam0)
+
+		var headerParam1 string
+
+		headerParam1, err = runtime.StyleParamWithLocation("simple", false, "Upload-Length", runtime.ParamLocationHeader, params.UploadLength)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("Upload-Length", headerParam1)
+
+		if params.UploadMetadata != nil {
+			var headerParam2 string
+
+			headerParam2, err = runtime.StyleParamWithLocation("simple", false, "Upload-Metadata", runtime.ParamLocationHeader, *params.UploadMetadata)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Upload-Metadata", headerParam2)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewTusOffsetRequest generates requests for TusOffset
+func NewTusOffsetRequest(server string, uploadKey string, params *TusOffsetParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uploadKey", runtime.ParamLocationPath, uploadKey)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/tus/upload/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("HEAD", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Tus-Resumable", runtime.ParamLocationHeader, params.TusResumable)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("Tus-Resumable", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewTusPatchRequestWithBody generates requests for TusPatch with any type of body
+func NewTusPatchRequestWithBody(server string, uploadKey string, params *TusPatchParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uploadKey", runtime.ParamLocationPath, uploadKey)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/tus/upload/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Tus-Resumable", runtime.ParamLocationHeader, params.TusResumable)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("Tus-Resumable", headerParam0)
+
+		var headerParam1 string
+
+		headerParam1, err = runtime.StyleParamWithLocation("simple", false, "Upload-Offset", runtime.ParamLocationHeader, params.UploadOffset)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("Upload-Offset", headerParam1)
+
+	}
+
+	return req, nil
+}
+
+// NewUploadRequestWithBody generates requests for Upload with any type of body
+func NewUploadRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/uploads")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewUploadConfigRequest generates requests for UploadConfig
+func NewUploadConfigRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/uploads/config")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCurrentUserRequest generates requests for CurrentUser
+func NewCurrentUserRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/user/me")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSpaceUsageRequest generates requests for SpaceUsage
+func NewSpaceUsageRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/user/space-usage")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// LoginWithBodyWithResponse request with any body
+	LoginWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LoginResponse, error)
+
+	LoginWithResponse(ctx context.Context, body LoginJSONRequestBody, reqEditors ...RequestEditorFn) (*LoginResponse, error)
+
+	// RegisterWithBodyWithResponse request with any body
+	RegisterWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RegisterResponse, error)
+
+	RegisterWithResponse(ctx context.Context, body RegisterJSONRequestBody, reqEditors ...RequestEditorFn) (*RegisterResponse, error)
+
+	// IndexEntryWithResponse request
+	IndexEntryWithResponse(ctx context.Context, params *IndexEntryParams, reqEditors ...RequestEditorFn) (*IndexEntryResponse, error)
+
+	// EntriesDeleteWithBodyWithResponse request with any body
+	EntriesDeleteWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EntriesDeleteResponse, error)
+
+	EntriesDeleteWithResponse(ctx context.Context, body EntriesDeleteJSONRequestBody, reqEditors ...RequestEditorFn) (*EntriesDeleteResponse, error)
+
+	// EntriesCopyWithBodyWithResponse request with any body
+	EntriesCopyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EntriesCopyResponse, error)
+
+	EntriesCopyWithResponse(ctx context.Context, body EntriesCopyJSONRequestBody, reqEditors ...RequestEditorFn) (*EntriesCopyResponse, error)
+
+	// EntriesMoveWithBodyWithResponse request with any body
+	EntriesMoveWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EntriesMoveResponse, error)
+
+	EntriesMoveWithResponse(ctx context.Context, body EntriesMoveJSONRequestBody, reqEditors ...RequestEditorFn) (*EntriesMoveResponse, error)
+
+	// EntriesRestoreWithBodyWithResponse request with any body
+	EntriesRestoreWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EntriesRestoreResponse, error)
+
+	EntriesRestoreWithResponse(ctx context.Context, body EntriesRestoreJSONRequestBody, reqEditors ...RequestEditorFn) (*EntriesRestoreResponse, error)
+
+	// PostFileEntriesStarWithBodyWithResponse request with any body
+	PostFileEntriesStarWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostFileEntriesStarResponse, error)
+
+	PostFileEntriesStarWithResponse(ctx context.Context, body PostFileEntriesStarJSONRequestBody, reqEditors ...RequestEditorFn) (*PostFileEntriesStarResponse, error)
+
+	// PostFileEntriesUnstarWithBodyWithResponse request with any body
+	PostFileEntriesUnstarWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostFileEntriesUnstarResponse, error)
+
+	PostFileEntriesUnstarWithResponse(ctx context.Context, body PostFileEntriesUnstarJSONRequestBody, reqEditors ...RequestEditorFn) (*PostFileEntriesUnstarResponse, error)
+
+	// ShowEntryWithResponse request
+	ShowEntryWithResponse(ctx context.Context, entryId int64, reqEditors ...RequestEditorFn) (*ShowEntryResponse, error)
+
+	// EntryUpdateWithBodyWithResponse request with any body
+	EntryUpdateWithBodyWithResponse(ctx context.Context, entryId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EntryUpdateResponse, error)
+
+	EntryUpdateWithResponse(ctx context.Context, entryId int, body EntryUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*EntryUpdateResponse, error)
+
+	// PutFileEntriesEntryIdChangePermissionsWithBodyWithResponse request with any body
+	PutFileEntriesEntryIdChangePermissionsWithBodyWithResponse(ctx context.Context, entryId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutFileEntriesEntryIdChangePermissionsResponse, error)
+
+	PutFileEntriesEntryIdChangePermissionsWithResponse(ctx context.Context, entryId int, body PutFileEntriesEntryIdChangePermissionsJSONRequestBody, reqEditors ...RequestEditorFn) (*PutFileEntriesEntryIdChangePermissionsResponse, error)
+
+	// UpdateEntryContentWithBodyWithResponse request with any body
+	UpdateEntryContentWithBodyWithResponse(ctx context.Context, entryId int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateEntryContentResponse, error)
+
+	// DownloadEntryWithResponse request
+	DownloadEntryWithResponse(ctx context.Context, entryId int64, params *DownloadEntryParams, reqEditors ...RequestEditorFn) (*DownloadEntryResponse, error)
+
+	// PostFileEntriesEntryIdShareWithBodyWithResponse request with any body
+	PostFileEntriesEntryIdShareWithBodyWithResponse(ctx context.Context, entryId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostFileEntriesEntryIdShareResponse, error)
+
+	PostFileEntriesEntryIdShareWithResponse(ctx context.Context, entryId int, body PostFileEntriesEntryIdShareJSONRequestBody, reqEditors ...RequestEditorFn) (*PostFileEntriesEntryIdShareResponse, error)
+
+	// GetShareableLinkWithResponse request
+	GetShareableLinkWithResponse(ctx context.Context, entryId int64, reqEditors ...RequestEditorFn) (*GetShareableLinkResponse, error)
 
 	// CreateShareableLinkWithBodyWithResponse request with any body
 	CreateShareableLinkWithBodyWithResponse(ctx context.Context, entryId int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateShareableLinkResponse, error)
 
-	CreateShareableLinkWithResponse(ctx context.Context, entryId int64, body CreateShareableLinkJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateShareableLinkResponse, error)
+	CreateShareableLinkWithResponse(ctx context.Context, entryId int64, body CreateShareableLinkJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateShareableLinkResponse, error)
+
+	// DeleteFileEntriesEntryIdUnshareWithBodyWithResponse request with any body
+	DeleteFileEntriesEntryIdUnshareWithBodyWithResponse(ctx context.Context, entryId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeleteFileEntriesEntryIdUnshareResponse, error)
+
+	DeleteFileEntriesEntryIdUnshareWithResponse(ctx context.Context, entryId int, body DeleteFileEntriesEntryIdUnshareJSONRequestBody, reqEditors ...RequestEditorFn) (*DeleteFileEntriesEntryIdUnshareResponse, error)
+
+	// DeleteShareableLinkWithResponse request
+	DeleteShareableLinkWithResponse(ctx context.Context, entryId int64, reqEditors ...RequestEditorFn) (*DeleteShareableLinkResponse, error)
+
+	// UpdateShareableLinkWithBodyWithResponse request with any body
+	UpdateShareableLinkWithBodyWithResponse(ctx context.Context, entryId int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateShareableLinkResponse, error)
+
+	UpdateShareableLinkWithResponse(ctx context.Context, entryId int64, body UpdateShareableLinkJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateShareableLinkResponse, error)
+
+	// CreateFolderWithBodyWithResponse request with any body
+	CreateFolderWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateFolderResponse, error)
+
+	CreateFolderWithResponse(ctx context.Context, body CreateFolderJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateFolderResponse, error)
+
+	// ShowShareableLinkWithResponse request
+	ShowShareableLinkWithResponse(ctx context.Context, hash string, params *ShowShareableLinkParams, reqEditors ...RequestEditorFn) (*ShowShareableLinkResponse, error)
+
+	// DownloadShareableLinkWithResponse request
+	DownloadShareableLinkWithResponse(ctx context.Context, hash string, params *DownloadShareableLinkParams, reqEditors ...RequestEditorFn) (*DownloadShareableLinkResponse, error)
+
+	// TusEntryWithBodyWithResponse request with any body
+	TusEntryWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TusEntryResponse, error)
+
+	TusEntryWithResponse(ctx context.Context, body TusEntryJSONRequestBody, reqEditors ...RequestEditorFn) (*TusEntryResponse, error)
+
+	// TusCreateWithResponse request
+	TusCreateWithResponse(ctx context.Context, params *TusCreateParams, reqEditors ...RequestEditorFn) (*TusCreateResponse, error)
+
+	// TusOffsetWithResponse request
+	TusOffsetWithResponse(ctx context.Context, uploadKey string, params *TusOffsetParams, reqEditors ...RequestEditorFn) (*TusOffsetResponse, error)
+
+	// TusPatchWithBodyWithResponse request with any body
+	TusPatchWithBodyWithResponse(ctx context.Context, uploadKey string, params *TusPatchParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TusPatchResponse, error)
+
+	// UploadWithBodyWithResponse request with any body
+	UploadWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UploadResponse, error)
+
+	// UploadConfigWithResponse request
+	UploadConfigWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UploadConfigResponse, error)
+
+	// CurrentUserWithResponse request
+	CurrentUserWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*CurrentUserResponse, error)
+
+	// SpaceUsageWithResponse request
+	SpaceUsageWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*SpaceUsageResponse, error)
+}
+
+type LoginResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Status *string `json:"status,omitempty"`
+		User   *User   `json:"user,omitempty"`
+	}
+	JSON422 *N422Response
+}
+
+// Status returns HTTPResponse.Status
+func (r LoginResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LoginResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RegisterResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Status *string `json:"status,omitempty"`
+		User   *User   `json:"user,omitempty"`
+	}
+	JSON422 *N422Response
+}
+
+// Status returns HTTPResponse.Status
+func (r RegisterResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RegisterResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type IndexEntryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]FileEntry
+}
+
+// Status returns HTTPResponse.Status
+func (r IndexEntryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r IndexEntryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type EntriesDeleteResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Status *string `json:"status,omitempty"`
+	}
+	JSON422 *N422Response
+}
+
+// Status returns HTTPResponse.Status
+func (r EntriesDeleteResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// DeleteFileEntriesEntryIdUnshareWithBodyWithResponse request with any body
-	DeleteFileEntriesEntryIdUnshareWithBodyWithResponse(ctx context.Context, entryId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeleteFileEntriesEntryIdUnshareResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r EntriesDeleteResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	DeleteFileEntriesEntryIdUnshareWithResponse(ctx context.Context, entryId int, body DeleteFileEntriesEntryIdUnshareJSONRequestBody, reqEditors ...RequestEditorFn) (*DeleteFileEntriesEntryIdUnshareResponse, error)
+type EntriesCopyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Entries *[]FileEntry `json:"entries,omitempty"`
+		Status  *string      `json:"status,omitempty"`
+	}
+	JSON422 *N422Response
+}
 
-	// DeleteShareableLinkWithResponse request
-	DeleteShareableLinkWithResponse(ctx context.Context, entryId int64, reqEditors ...RequestEditorFn) (*DeleteShareableLinkResponse, error)
+// Status returns HTTPResponse.Status
+func (r EntriesCopyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// UpdateShareableLinkWithBodyWithResponse request with any body
-	UpdateShareableLinkWithBodyWithResponse(ctx context.Context, entryId int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateShareableLinkResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r EntriesCopyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	UpdateShareableLinkWithResponse(ctx context.Context, entryId int64, body UpdateShareableLinkJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateShareableLinkResponse, error)
+type EntriesMoveResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Entries *[]FileEntry `json:"entries,omitempty"`
+		Status  *string      `json:"status,omitempty"`
+	}
+	JSON422 *N422Response
+}
 
-	// CreateFolderWithBodyWithResponse request with any body
-	CreateFolderWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateFolderResponse, error)
+// Status returns HTTPResponse.Status
+func (r EntriesMoveResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	CreateFolderWithResponse(ctx context.Context, body CreateFolderJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateFolderResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r EntriesMoveResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	// UploadWithBodyWithResponse request with any body
-	UploadWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UploadResponse, error)
+type EntriesRestoreResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Status *string `json:"status,omitempty"`
+	}
+	JSON422 *N422Response
 }
 
-type LoginResponse struct {
+// Status returns HTTPResponse.Status
+func (r EntriesRestoreResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r EntriesRestoreResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostFileEntriesStarResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	JSON200      *struct {
 		Status *string `json:"status,omitempty"`
-		User   *User   `json:"user,omitempty"`
+		Tag    *Tag    `json:"tag,omitempty"`
 	}
 	JSON422 *N422Response
 }
 
 // Status returns HTTPResponse.Status
-func (r LoginResponse) Status() string {
+func (r PostFileEntriesStarResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -2006,25 +3079,25 @@ func (r LoginResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r LoginResponse) StatusCode() int {
+func (r PostFileEntriesStarResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type RegisterResponse struct {
+type PostFileEntriesUnstarResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	JSON200      *struct {
 		Status *string `json:"status,omitempty"`
-		User   *User   `json:"user,omitempty"`
+		Tag    *Tag    `json:"tag,omitempty"`
 	}
 	JSON422 *N422Response
 }
 
 // Status returns HTTPResponse.Status
-func (r RegisterResponse) Status() string {
+func (r PostFileEntriesUnstarResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -2032,21 +3105,24 @@ func (r RegisterResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r RegisterResponse) StatusCode() int {
+func (r PostFileEntriesUnstarResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type IndexEntryResponse struct {
+type ShowEntryResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *[]FileEntry
+	JSON200      *struct {
+		FileEntry *FileEntry `json:"fileEntry,omitempty"`
+		Status    *string    `json:"status,omitempty"`
+	}
 }
 
 // Status returns HTTPResponse.Status
-func (r IndexEntryResponse) Status() string {
+func (r ShowEntryResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -2054,24 +3130,51 @@ func (r IndexEntryResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r IndexEntryResponse) StatusCode() int {
+func (r ShowEntryResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type EntriesDeleteResponse struct {
+type EntryUpdateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		FileEntry *FileEntry `json:"fileEntry,omitempty"`
+		Status    *string    `json:"status,omitempty"`
+	}
+	JSON422 *N422Response
+}
+
+// Status returns HTTPResponse.Status
+func (r EntryUpdateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r EntryUpdateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutFileEntriesEntryIdChangePermissionsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	JSON200      *struct {
 		Status *string `json:"status,omitempty"`
+		Users  *[]User `json:"users,omitempty"`
 	}
 	JSON422 *N422Response
 }
 
 // Status returns HTTPResponse.Status
-func (r EntriesDeleteResponse) Status() string {
+func (r PutFileEntriesEntryIdChangePermissionsResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -2079,25 +3182,25 @@ func (r EntriesDeleteResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r EntriesDeleteResponse) StatusCode() int {
+func (r PutFileEntriesEntryIdChangePermissionsResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type EntriesCopyResponse struct {
+type UpdateEntryContentResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	JSON200      *struct {
-		Entries *[]FileEntry `json:"entries,omitempty"`
-		Status  *string      `json:"status,omitempty"`
+		FileEntry *FileEntry `json:"fileEntry,omitempty"`
+		Status    *string    `json:"status,omitempty"`
 	}
 	JSON422 *N422Response
 }
 
 // Status returns HTTPResponse.Status
-func (r EntriesCopyResponse) Status() string {
+func (r UpdateEntryContentResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -2105,25 +3208,121 @@ func (r EntriesCopyResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r EntriesCopyResponse) StatusCode() int {
+func (r UpdateEntryContentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DownloadEntryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DownloadEntryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DownloadEntryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostFileEntriesEntryIdShareResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Status *string `json:"status,omitempty"`
+		Users  *[]User `json:"users,omitempty"`
+	}
+	JSON422 *N422Response
+}
+
+// Status returns HTTPResponse.Status
+func (r PostFileEntriesEntryIdShareResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostFileEntriesEntryIdShareResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetShareableLinkResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ShareableLinkResponse
+	JSON422      *N422Response
+}
+
+// Status returns HTTPResponse.Status
+func (r GetShareableLinkResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetShareableLinkResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateShareableLinkResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Link   *ShareableLink `json:"link,omitempty"`
+		Status *string        `json:"status,omitempty"`
+	}
+	JSON422 *N422Response
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateShareableLinkResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateShareableLinkResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type EntriesMoveResponse struct {
+type DeleteFileEntriesEntryIdUnshareResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	JSON200      *struct {
-		Entries *[]FileEntry `json:"entries,omitempty"`
-		Status  *string      `json:"status,omitempty"`
+		Status *string `json:"status,omitempty"`
+		Users  *[]User `json:"users,omitempty"`
 	}
 	JSON422 *N422Response
 }
 
 // Status returns HTTPResponse.Status
-func (r EntriesMoveResponse) Status() string {
+func (r DeleteFileEntriesEntryIdUnshareResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -2131,14 +3330,14 @@ func (r EntriesMoveResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r EntriesMoveResponse) StatusCode() int {
+func (r DeleteFileEntriesEntryIdUnshareResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type EntriesRestoreResponse struct {
+type DeleteShareableLinkResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	JSON200      *struct {
@@ -2148,7 +3347,7 @@ type EntriesRestoreResponse struct {
 }
 
 // Status returns HTTPResponse.Status
-func (r EntriesRestoreResponse) Status() string {
+func (r DeleteShareableLinkResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -2156,25 +3355,25 @@ func (r EntriesRestoreResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r EntriesRestoreResponse) StatusCode() int {
+func (r DeleteShareableLinkResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type PostFileEntriesStarResponse struct {
+type UpdateShareableLinkResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	JSON200      *struct {
-		Status *string `json:"status,omitempty"`
-		Tag    *Tag    `json:"tag,omitempty"`
+		Link   *ShareableLink `json:"link,omitempty"`
+		Status *string        `json:"status,omitempty"`
 	}
 	JSON422 *N422Response
 }
 
 // Status returns HTTPResponse.Status
-func (r PostFileEntriesStarResponse) Status() string {
+func (r UpdateShareableLinkResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -2182,25 +3381,25 @@ func (r PostFileEntriesStarResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PostFileEntriesStarResponse) StatusCode() int {
+func (r UpdateShareableLinkResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type PostFileEntriesUnstarResponse struct {
+type CreateFolderResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	JSON200      *struct {
-		Status *string `json:"status,omitempty"`
-		Tag    *Tag    `json:"tag,omitempty"`
+		Folder *FileEntry `json:"folder,omitempty"`
+		Status *string    `json:"status,omitempty"`
 	}
 	JSON422 *N422Response
 }
 
 // Status returns HTTPResponse.Status
-func (r PostFileEntriesUnstarResponse) Status() string {
+func (r CreateFolderResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -2208,25 +3407,21 @@ func (r PostFileEntriesUnstarResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PostFileEntriesUnstarResponse) StatusCode() int {
+func (r CreateFolderResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type EntryUpdateResponse struct {
+type ShowShareableLinkResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *struct {
-		FileEntry *FileEntry `json:"fileEntry,omitempty"`
-		Status    *string    `json:"status,omitempty"`
-	}
-	JSON422 *N422Response
+	JSON200      *ShareableLinkResponse
 }
 
 // Status returns HTTPResponse.Status
-func (r EntryUpdateResponse) Status() string {
+func (r ShowShareableLinkResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -2234,25 +3429,20 @@ func (r EntryUpdateResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r EntryUpdateResponse) StatusCode() int {
+func (r ShowShareableLinkResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type PutFileEntriesEntryIdChangePermissionsResponse struct {
+type DownloadShareableLinkResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *struct {
-		Status *string `json:"status,omitempty"`
-		Users  *[]User `json:"users,omitempty"`
-	}
-	JSON422 *N422Response
 }
 
 // Status returns HTTPResponse.Status
-func (r PutFileEntriesEntryIdChangePermissionsResponse) Status() string {
+func (r DownloadShareableLinkResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -2260,25 +3450,25 @@ func (r PutFileEntriesEntryIdChangePermissionsResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PutFileEntriesEntryIdChangePermissionsResponse) StatusCode() int {
+func (r DownloadShareableLinkResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type PostFileEntriesEntryIdShareResponse struct {
+type TusEntryResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *struct {
-		Status *string `json:"status,omitempty"`
-		Users  *[]User `json:"users,omitempty"`
+	JSON201      *struct {
+		FileEntry *FileEntry `json:"fileEntry,omitempty"`
+		Status    *string    `json:"status,omitempty"`
 	}
 	JSON422 *N422Response
 }
 
 // Status returns HTTPResponse.Status
-func (r PostFileEntriesEntryIdShareResponse) Status() string {
+func (r TusEntryResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -2286,22 +3476,20 @@ func (r PostFileEntriesEntryIdShareResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PostFileEntriesEntryIdShareResponse) StatusCode() int {
+func (r TusEntryResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetShareableLinkResponse struct {
+type TusCreateResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *ShareableLinkResponse
-	JSON422      *N422Response
 }
 
 // Status returns HTTPResponse.Status
-func (r GetShareableLinkResponse) Status() string {
+func (r TusCreateResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -2309,25 +3497,20 @@ func (r GetShareableLinkResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetShareableLinkResponse) StatusCode() int {
+func (r TusCreateResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type CreateShareableLinkResponse struct {
+type TusOffsetResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *struct {
-		Link   *ShareableLink `json:"link,omitempty"`
-		Status *string        `json:"status,omitempty"`
-	}
-	JSON422 *N422Response
 }
 
 // Status returns HTTPResponse.Status
-func (r CreateShareableLinkResponse) Status() string {
+func (r TusOffsetResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -2335,25 +3518,20 @@ func (r CreateShareableLinkResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r CreateShareableLinkResponse) StatusCode() int {
+func (r TusOffsetResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type DeleteFileEntriesEntryIdUnshareResponse struct {
+type TusPatchResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *struct {
-		Status *string `json:"status,omitempty"`
-		Users  *[]User `json:"users,omitempty"`
-	}
-	JSON422 *N422Response
 }
 
 // Status returns HTTPResponse.Status
-func (r DeleteFileEntriesEntryIdUnshareResponse) Status() string {
+func (r TusPatchResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -2361,24 +3539,25 @@ func (r DeleteFileEntriesEntryIdUnshareResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DeleteFileEntriesEntryIdUnshareResponse) StatusCode() int {
+func (r TusPatchResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type DeleteShareableLinkResponse struct {
+type UploadResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *struct {
-		Status *string `json:"status,omitempty"`
+	JSON201      *struct {
+		FileEntry *FileEntry `json:"fileEntry,omitempty"`
+		Status    *string    `json:"status,omitempty"`
 	}
 	JSON422 *N422Response
 }
 
 // Status returns HTTPResponse.Status
-func (r DeleteShareableLinkResponse) Status() string {
+func (r UploadResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -2386,25 +3565,25 @@ func (r DeleteShareableLinkResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DeleteShareableLinkResponse) StatusCode() int {
+func (r UploadResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type UpdateShareableLinkResponse struct {
+type UploadConfigResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	JSON200      *struct {
-		Link   *ShareableLink `json:"link,omitempty"`
-		Status *string        `json:"status,omitempty"`
+		// MaxSize Maximum size of a single uploaded file in bytes, or 0 if unlimited
+		MaxSize *int64  `json:"max_size,omitempty"`
+		Status  *string `json:"status,omitempty"`
 	}
-	JSON422 *N422Response
 }
 
 // Status returns HTTPResponse.Status
-func (r UpdateShareableLinkResponse) Status() string {
+func (r UploadConfigResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -2412,25 +3591,32 @@ func (r UpdateShareableLinkResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r UpdateShareableLinkResponse) StatusCode() int {
+func (r UploadConfigResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type CreateFolderResponse struct {
+type CurrentUserResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	JSON200      *struct {
-		Folder *FileEntry `json:"folder,omitempty"`
-		Status *string    `json:"status,omitempty"`
+		// AvailableSpace Total storage allowed for the user in bytes, or 0 if unlimited
+		AvailableSpace *int64 `json:"available_space,omitempty"`
+
+		// MaxFileSize Maximum size of a single uploaded file in bytes, or 0 if unlimited
+		MaxFileSize *int64 `json:"max_file_size,omitempty"`
+
+		// Plan Name of the user's subscription plan
+		Plan   *string `json:"plan,omitempty"`
+		Status *string `json:"status,omitempty"`
+		User   *User   `json:"user,omitempty"`
 	}
-	JSON422 *N422Response
 }
 
 // Status returns HTTPResponse.Status
-func (r CreateFolderResponse) Status() string {
+func (r CurrentUserResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -2438,25 +3624,28 @@ func (r CreateFolderResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r CreateFolderResponse) StatusCode() int {
+func (r CurrentUserResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type UploadResponse struct {
+type SpaceUsageResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON201      *struct {
-		FileEntry *FileEntry `json:"fileEntry,omitempty"`
-		Status    *string    `json:"status,omitempty"`
+	JSON200      *struct {
+		// Available Total storage allowed for the current user in bytes, or 0 if unlimited
+		Available *int64  `json:"available,omitempty"`
+		Status    *string `json:"status,omitempty"`
+
+		// Used Storage used by the current user in bytes
+		Used *int64 `json:"used,omitempty"`
 	}
-	JSON422 *N422Response
 }
 
 // Status returns HTTPResponse.Status
-func (r UploadResponse) Status() string {
+func (r SpaceUsageResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -2464,7 +3653,7 @@ func (r UploadResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r UploadResponse) StatusCode() int {
+func (r SpaceUsageResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -2616,6 +3805,15 @@ func (c *ClientWithResponses) PostFileEntriesUnstarWithResponse(ctx context.Cont
 	return ParsePostFileEntriesUnstarResponse(rsp)
 }
 
+// ShowEntryWithResponse request returning *ShowEntryResponse
+func (c *ClientWithResponses) ShowEntryWithResponse(ctx context.Context, entryId int64, reqEditors ...RequestEditorFn) (*ShowEntryResponse, error) {
+	rsp, err := c.ShowEntry(ctx, entryId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseShowEntryResponse(rsp)
+}
+
 // EntryUpdateWithBodyWithResponse request with arbitrary body returning *EntryUpdateResponse
 func (c *ClientWithResponses) EntryUpdateWithBodyWithResponse(ctx context.Context, entryId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EntryUpdateResponse, error) {
 	rsp, err := c.EntryUpdateWithBody(ctx, entryId, contentType, body, reqEditors...)
@@ -2650,6 +3848,24 @@ func (c *ClientWithResponses) PutFileEntriesEntryIdChangePermissionsWithResponse
 	return ParsePutFileEntriesEntryIdChangePermissionsResponse(rsp)
 }
 
+// UpdateEntryContentWithBodyWithResponse request with arbitrary body returning *UpdateEntryContentResponse
+func (c *ClientWithResponses) UpdateEntryContentWithBodyWithResponse(ctx context.Context, entryId int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateEntryContentResponse, error) {
+	rsp, err := c.UpdateEntryContentWithBody(ctx, entryId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateEntryContentResponse(rsp)
+}
+
+// DownloadEntryWithResponse request returning *DownloadEntryResponse
+func (c *ClientWithResponses) DownloadEntryWithResponse(ctx context.Context, entryId int64, params *DownloadEntryParams, reqEditors ...RequestEditorFn) (*DownloadEntryResponse, error) {
+	rsp, err := c.DownloadEntry(ctx, entryId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDownloadEntryResponse(rsp)
+}
+
 // PostFileEntriesEntryIdShareWithBodyWithResponse request with arbitrary body returning *PostFileEntriesEntryIdShareResponse
 func (c *ClientWithResponses) PostFileEntriesEntryIdShareWithBodyWithResponse(ctx context.Context, entryId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostFileEntriesEntryIdShareResponse, error) {
 	rsp, err := c.PostFileEntriesEntryIdShareWithBody(ctx, entryId, contentType, body, reqEditors...)
@@ -2667,110 +3883,439 @@ func (c *ClientWithResponses) PostFileEntriesEntryIdShareWithResponse(ctx contex
 	return ParsePostFileEntriesEntryIdShareResponse(rsp)
 }
 
-// GetShareableLinkWithResponse request returning *GetShareableLinkResponse
-func (c *ClientWithResponses) GetShareableLinkWithResponse(ctx context.Context, entryId int64, reqEditors ...RequestEditorFn) (*GetShareableLinkResponse, error) {
-	rsp, err := c.GetShareableLink(ctx, entryId, reqEditors...)
+// GetShareableLinkWithResponse request returning *GetShareableLinkResponse
+func (c *ClientWithResponses) GetShareableLinkWithResponse(ctx context.Context, entryId int64, reqEditors ...RequestEditorFn) (*GetShareableLinkResponse, error) {
+	rsp, err := c.GetShareableLink(ctx, entryId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetShareableLinkResponse(rsp)
+}
+
+// CreateShareableLinkWithBodyWithResponse request with arbitrary body returning *CreateShareableLinkResponse
+func (c *ClientWithResponses) CreateShareableLinkWithBodyWithResponse(ctx context.Context, entryId int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateShareableLinkResponse, error) {
+	rsp, err := c.CreateShareableLinkWithBody(ctx, entryId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateShareableLinkResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateShareableLinkWithResponse(ctx context.Context, entryId int64, body CreateShareableLinkJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateShareableLinkResponse, error) {
+	rsp, err := c.CreateShareableLink(ctx, entryId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateShareableLinkResponse(rsp)
+}
+
+// DeleteFileEntriesEntryIdUnshareWithBodyWithResponse request with arbitrary body returning *DeleteFileEntriesEntryIdUnshareResponse
+func (c *ClientWithResponses) DeleteFileEntriesEntryIdUnshareWithBodyWithResponse(ctx context.Context, entryId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeleteFileEntriesEntryIdUnshareResponse, error) {
+	rsp, err := c.DeleteFileEntriesEntryIdUnshareWithBody(ctx, entryId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteFileEntriesEntryIdUnshareResponse(rsp)
+}
+
+func (c *ClientWithResponses) DeleteFileEntriesEntryIdUnshareWithResponse(ctx context.Context, entryId int, body DeleteFileEntriesEntryIdUnshareJSONRequestBody, reqEditors ...RequestEditorFn) (*DeleteFileEntriesEntryIdUnshareResponse, error) {
+	rsp, err := c.DeleteFileEntriesEntryIdUnshare(ctx, entryId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteFileEntriesEntryIdUnshareResponse(rsp)
+}
+
+// DeleteShareableLinkWithResponse request returning *DeleteShareableLinkResponse
+func (c *ClientWithResponses) DeleteShareableLinkWithResponse(ctx context.Context, entryId int64, reqEditors ...RequestEditorFn) (*DeleteShareableLinkResponse, error) {
+	rsp, err := c.DeleteShareableLink(ctx, entryId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteShareableLinkResponse(rsp)
+}
+
+// UpdateShareableLinkWithBodyWithResponse request with arbitrary body returning *UpdateShareableLinkResponse
+func (c *ClientWithResponses) UpdateShareableLinkWithBodyWithResponse(ctx context.Context, entryId int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateShareableLinkResponse, error) {
+	rsp, err := c.UpdateShareableLinkWithBody(ctx, entryId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateShareableLinkResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateShareableLinkWithResponse(ctx context.Context, entryId int64, body UpdateShareableLinkJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateShareableLinkResponse, error) {
+	rsp, err := c.UpdateShareableLink(ctx, entryId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateShareableLinkResponse(rsp)
+}
+
+// CreateFolderWithBodyWithResponse request with arbitrary body returning *CreateFolderResponse
+func (c *ClientWithResponses) CreateFolderWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateFolderResponse, error) {
+	rsp, err := c.CreateFolderWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateFolderResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateFolderWithResponse(ctx context.Context, body CreateFolderJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateFolderResponse, error) {
+	rsp, err := c.CreateFolder(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateFolderResponse(rsp)
+}
+
+// ShowShareableLinkWithResponse request returning *ShowShareableLinkResponse
+func (c *ClientWithResponses) ShowShareableLinkWithResponse(ctx context.Context, hash string, params *ShowShareableLinkParams, reqEditors ...RequestEditorFn) (*ShowShareableLinkResponse, error) {
+	rsp, err := c.ShowShareableLink(ctx, hash, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseShowShareableLinkResponse(rsp)
+}
+
+// DownloadShareableLinkWithResponse request returning *DownloadShareableLinkResponse
+func (c *ClientWithResponses) DownloadShareableLinkWithResponse(ctx context.Context, hash string, params *DownloadShareableLinkParams, reqEditors ...RequestEditorFn) (*DownloadShareableLinkResponse, error) {
+	rsp, err := c.DownloadShareableLink(ctx, hash, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDownloadShareableLinkResponse(rsp)
+}
+
+// TusEntryWithBodyWithResponse request with arbitrary body returning *TusEntryResponse
+func (c *ClientWithResponses) TusEntryWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TusEntryResponse, error) {
+	rsp, err := c.TusEntryWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTusEntryResponse(rsp)
+}
+
+func (c *ClientWithResponses) TusEntryWithResponse(ctx context.Context, body TusEntryJSONRequestBody, reqEditors ...RequestEditorFn) (*TusEntryResponse, error) {
+	rsp, err := c.TusEntry(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTusEntryResponse(rsp)
+}
+
+// TusCreateWithResponse request returning *TusCreateResponse
+func (c *ClientWithResponses) TusCreateWithResponse(ctx context.Context, params *TusCreateParams, reqEditors ...RequestEditorFn) (*TusCreateResponse, error) {
+	rsp, err := c.TusCreate(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTusCreateResponse(rsp)
+}
+
+// TusOffsetWithResponse request returning *TusOffsetResponse
+func (c *ClientWithResponses) TusOffsetWithResponse(ctx context.Context, uploadKey string, params *TusOffsetParams, reqEditors ...RequestEditorFn) (*TusOffsetResponse, error) {
+	rsp, err := c.TusOffset(ctx, uploadKey, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTusOffsetResponse(rsp)
+}
+
+// TusPatchWithBodyWithResponse request with arbitrary body returning *TusPatchResponse
+func (c *ClientWithResponses) TusPatchWithBodyWithResponse(ctx context.Context, uploadKey string, params *TusPatchParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TusPatchResponse, error) {
+	rsp, err := c.TusPatchWithBody(ctx, uploadKey, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTusPatchResponse(rsp)
+}
+
+// UploadWithBodyWithResponse request with arbitrary body returning *UploadResponse
+func (c *ClientWithResponses) UploadWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UploadResponse, error) {
+	rsp, err := c.UploadWithBody(ctx, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetShareableLinkResponse(rsp)
+	return ParseUploadResponse(rsp)
 }
 
-// CreateShareableLinkWithBodyWithResponse request with arbitrary body returning *CreateShareableLinkResponse
-func (c *ClientWithResponses) CreateShareableLinkWithBodyWithResponse(ctx context.Context, entryId int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateShareableLinkResponse, error) {
-	rsp, err := c.CreateShareableLinkWithBody(ctx, entryId, contentType, body, reqEditors...)
+// UploadConfigWithResponse request returning *UploadConfigResponse
+func (c *ClientWithResponses) UploadConfigWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UploadConfigResponse, error) {
+	rsp, err := c.UploadConfig(ctx, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateShareableLinkResponse(rsp)
+	return ParseUploadConfigResponse(rsp)
 }
 
-func (c *ClientWithResponses) CreateShareableLinkWithResponse(ctx context.Context, entryId int64, body CreateShareableLinkJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateShareableLinkResponse, error) {
-	rsp, err := c.CreateShareableLink(ctx, entryId, body, reqEditors...)
+// CurrentUserWithResponse request returning *CurrentUserResponse
+func (c *ClientWithResponses) CurrentUserWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*CurrentUserResponse, error) {
+	rsp, err := c.CurrentUser(ctx, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateShareableLinkResponse(rsp)
+	return ParseCurrentUserResponse(rsp)
 }
 
-// DeleteFileEntriesEntryIdUnshareWithBodyWithResponse request with arbitrary body returning *DeleteFileEntriesEntryIdUnshareResponse
-func (c *ClientWithResponses) DeleteFileEntriesEntryIdUnshareWithBodyWithResponse(ctx context.Context, entryId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeleteFileEntriesEntryIdUnshareResponse, error) {
-	rsp, err := c.DeleteFileEntriesEntryIdUnshareWithBody(ctx, entryId, contentType, body, reqEditors...)
+// SpaceUsageWithResponse request returning *SpaceUsageResponse
+func (c *ClientWithResponses) SpaceUsageWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*SpaceUsageResponse, error) {
+	rsp, err := c.SpaceUsage(ctx, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteFileEntriesEntryIdUnshareResponse(rsp)
+	return ParseSpaceUsageResponse(rsp)
 }
 
-func (c *ClientWithResponses) DeleteFileEntriesEntryIdUnshareWithResponse(ctx context.Context, entryId int, body DeleteFileEntriesEntryIdUnshareJSONRequestBody, reqEditors ...RequestEditorFn) (*DeleteFileEntriesEntryIdUnshareResponse, error) {
-	rsp, err := c.DeleteFileEntriesEntryIdUnshare(ctx, entryId, body, reqEditors...)
+// ParseLoginResponse parses an HTTP response from a LoginWithResponse call
+func ParseLoginResponse(rsp *http.Response) (*LoginResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteFileEntriesEntryIdUnshareResponse(rsp)
+
+	response := &LoginResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Status *string `json:"status,omitempty"`
+			User   *User   `json:"user,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest N422Response
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	}
+
+	return response, nil
 }
 
-// DeleteShareableLinkWithResponse request returning *DeleteShareableLinkResponse
-func (c *ClientWithResponses) DeleteShareableLinkWithResponse(ctx context.Context, entryId int64, reqEditors ...RequestEditorFn) (*DeleteShareableLinkResponse, error) {
-	rsp, err := c.DeleteShareableLink(ctx, entryId, reqEditors...)
+// ParseRegisterResponse parses an HTTP response from a RegisterWithResponse call
+func ParseRegisterResponse(rsp *http.Response) (*RegisterResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteShareableLinkResponse(rsp)
+
+	response := &RegisterResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Status *string `json:"status,omitempty"`
+			User   *User   `json:"user,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest N422Response
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	}
+
+	return response, nil
 }
 
-// UpdateShareableLinkWithBodyWithResponse request with arbitrary body returning *UpdateShareableLinkResponse
-func (c *ClientWithResponses) UpdateShareableLinkWithBodyWithResponse(ctx context.Context, entryId int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateShareableLinkResponse, error) {
-	rsp, err := c.UpdateShareableLinkWithBody(ctx, entryId, contentType, body, reqEditors...)
+// ParseIndexEntryResponse parses an HTTP response from a IndexEntryWithResponse call
+func ParseIndexEntryResponse(rsp *http.Response) (*IndexEntryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateShareableLinkResponse(rsp)
+
+	response := &IndexEntryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []FileEntry
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) UpdateShareableLinkWithResponse(ctx context.Context, entryId int64, body UpdateShareableLinkJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateShareableLinkResponse, error) {
-	rsp, err := c.UpdateShareableLink(ctx, entryId, body, reqEditors...)
+// ParseEntriesDeleteResponse parses an HTTP response from a EntriesDeleteWithResponse call
+func ParseEntriesDeleteResponse(rsp *http.Response) (*EntriesDeleteResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateShareableLinkResponse(rsp)
+
+	response := &EntriesDeleteResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Status *string `json:"status,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest N422Response
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	}
+
+	return response, nil
 }
 
-// CreateFolderWithBodyWithResponse request with arbitrary body returning *CreateFolderResponse
-func (c *ClientWithResponses) CreateFolderWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateFolderResponse, error) {
-	rsp, err := c.CreateFolderWithBody(ctx, contentType, body, reqEditors...)
+// ParseEntriesCopyResponse parses an HTTP response from a EntriesCopyWithResponse call
+func ParseEntriesCopyResponse(rsp *http.Response) (*EntriesCopyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateFolderResponse(rsp)
+
+	response := &EntriesCopyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Entries *[]FileEntry `json:"entries,omitempty"`
+			Status  *string      `json:"status,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest N422Response
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	}
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) CreateFolderWithResponse(ctx context.Context, body CreateFolderJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateFolderResponse, error) {
-	rsp, err := c.CreateFolder(ctx, body, reqEditors...)
+// ParseEntriesMoveResponse parses an HTTP response from a EntriesMoveWithResponse call
+func ParseEntriesMoveResponse(rsp *http.Response) (*EntriesMoveResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateFolderResponse(rsp)
+
+	response := &EntriesMoveResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Entries *[]FileEntry `json:"entries,omitempty"`
+			Status  *string      `json:"status,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest N422Response
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	}
+
+	return response, nil
 }
 
-// UploadWithBodyWithResponse request with arbitrary body returning *UploadResponse
-func (c *ClientWithResponses) UploadWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UploadResponse, error) {
-	rsp, err := c.UploadWithBody(ctx, contentType, body, reqEditors...)
+// ParseEntriesRestoreResponse parses an HTTP response from a EntriesRestoreWithResponse call
+func ParseEntriesRestoreResponse(rsp *http.Response) (*EntriesRestoreResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseUploadResponse(rsp)
+
+	response := &EntriesRestoreResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Status *string `json:"status,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest N422Response
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	}
+
+	return response, nil
 }
 
-// ParseLoginResponse parses an HTTP response from a LoginWithResponse call
-func ParseLoginResponse(rsp *http.Response) (*LoginResponse, error) {
+// ParsePostFileEntriesStarResponse parses an HTTP response from a PostFileEntriesStarWithResponse call
+func ParsePostFileEntriesStarResponse(rsp *http.Response) (*PostFileEntriesStarResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &LoginResponse{
+	response := &PostFileEntriesStarResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -2779,7 +4324,7 @@ func ParseLoginResponse(rsp *http.Response) (*LoginResponse, error) {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest struct {
 			Status *string `json:"status,omitempty"`
-			User   *User   `json:"user,omitempty"`
+			Tag    *Tag    `json:"tag,omitempty"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
@@ -2798,15 +4343,15 @@ func ParseLoginResponse(rsp *http.Response) (*LoginResponse, error) {
 	return response, nil
 }
 
-// ParseRegisterResponse parses an HTTP response from a RegisterWithResponse call
-func ParseRegisterResponse(rsp *http.Response) (*RegisterResponse, error) {
+// ParsePostFileEntriesUnstarResponse parses an HTTP response from a PostFileEntriesUnstarWithResponse call
+func ParsePostFileEntriesUnstarResponse(rsp *http.Response) (*PostFileEntriesUnstarResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &RegisterResponse{
+	response := &PostFileEntriesUnstarResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -2815,7 +4360,7 @@ func ParseRegisterResponse(rsp *http.Response) (*RegisterResponse, error) {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest struct {
 			Status *string `json:"status,omitempty"`
-			User   *User   `json:"user,omitempty"`
+			Tag    *Tag    `json:"tag,omitempty"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
@@ -2834,22 +4379,25 @@ func ParseRegisterResponse(rsp *http.Response) (*RegisterResponse, error) {
 	return response, nil
 }
 
-// ParseIndexEntryResponse parses an HTTP response from a IndexEntryWithResponse call
-func ParseIndexEntryResponse(rsp *http.Response) (*IndexEntryResponse, error) {
+// ParseShowEntryResponse parses an HTTP response from a ShowEntryWithResponse call
+func ParseShowEntryResponse(rsp *http.Response) (*ShowEntryResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &IndexEntryResponse{
+	response := &ShowEntryResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []FileEntry
+		var dest struct {
+			FileEntry *FileEntry `json:"fileEntry,omitempty"`
+			Status    *string    `json:"status,omitempty"`
+		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -2860,15 +4408,15 @@ func ParseIndexEntryResponse(rsp *http.Response) (*IndexEntryResponse, error) {
 	return response, nil
 }
 
-// ParseEntriesDeleteResponse parses an HTTP response from a EntriesDeleteWithResponse call
-func ParseEntriesDeleteResponse(rsp *http.Response) (*EntriesDeleteResponse, error) {
+// ParseEntryUpdateResponse parses an HTTP response from a EntryUpdateWithResponse call
+func ParseEntryUpdateResponse(rsp *http.Response) (*EntryUpdateResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &EntriesDeleteResponse{
+	response := &EntryUpdateResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -2876,7 +4424,8 @@ func ParseEntriesDeleteResponse(rsp *http.Response) (*EntriesDeleteResponse, err
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest struct {
-			Status *string `json:"status,omitempty"`
+			FileEntry *FileEntry `json:"fileEntry,omitempty"`
+			Status    *string    `json:"status,omitempty"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
@@ -2895,15 +4444,15 @@ func ParseEntriesDeleteResponse(rsp *http.Response) (*EntriesDeleteResponse, err
 	return response, nil
 }
 
-// ParseEntriesCopyResponse parses an HTTP response from a EntriesCopyWithResponse call
-func ParseEntriesCopyResponse(rsp *http.Response) (*EntriesCopyResponse, error) {
+// ParsePutFileEntriesEntryIdChangePermissionsResponse parses an HTTP response from a PutFileEntriesEntryIdChangePermissionsWithResponse call
+func ParsePutFileEntriesEntryIdChangePermissionsResponse(rsp *http.Response) (*PutFileEntriesEntryIdChangePermissionsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &EntriesCopyResponse{
+	response := &PutFileEntriesEntryIdChangePermissionsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -2911,8 +4460,8 @@ func ParseEntriesCopyResponse(rsp *http.Response) (*EntriesCopyResponse, error)
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest struct {
-			Entries *[]FileEntry `json:"entries,omitempty"`
-			Status  *string      `json:"status,omitempty"`
+			Status *string `json:"status,omitempty"`
+			Users  *[]User `json:"users,omitempty"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
@@ -2931,15 +4480,15 @@ func ParseEntriesCopyResponse(rsp *http.Response) (*EntriesCopyResponse, error)
 	return response, nil
 }
 
-// ParseEntriesMoveResponse parses an HTTP response from a EntriesMoveWithResponse call
-func ParseEntriesMoveResponse(rsp *http.Response) (*EntriesMoveResponse, error) {
+// ParseUpdateEntryContentResponse parses an HTTP response from a UpdateEntryContentWithResponse call
+func ParseUpdateEntryContentResponse(rsp *http.Response) (*UpdateEntryContentResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &EntriesMoveResponse{
+	response := &UpdateEntryContentResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -2947,8 +4496,8 @@ func ParseEntriesMoveResponse(rsp *http.Response) (*EntriesMoveResponse, error)
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest struct {
-			Entries *[]FileEntry `json:"entries,omitempty"`
-			Status  *string      `json:"status,omitempty"`
+			FileEntry *FileEntry `json:"fileEntry,omitempty"`
+			Status    *string    `json:"status,omitempty"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
@@ -2967,15 +4516,31 @@ func ParseEntriesMoveResponse(rsp *http.Response) (*EntriesMoveResponse, error)
 	return response, nil
 }
 
-// ParseEntriesRestoreResponse parses an HTTP response from a EntriesRestoreWithResponse call
-func ParseEntriesRestoreResponse(rsp *http.Response) (*EntriesRestoreResponse, error) {
+// ParseDownloadEntryResponse parses an HTTP response from a DownloadEntryWithResponse call
+func ParseDownloadEntryResponse(rsp *http.Response) (*DownloadEntryResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &EntriesRestoreResponse{
+	response := &DownloadEntryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParsePostFileEntriesEntryIdShareResponse parses an HTTP response from a PostFileEntriesEntryIdShareWithResponse call
+func ParsePostFileEntriesEntryIdShareResponse(rsp *http.Response) (*PostFileEntriesEntryIdShareResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostFileEntriesEntryIdShareResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -2984,6 +4549,7 @@ func ParseEntriesRestoreResponse(rsp *http.Response) (*EntriesRestoreResponse, e
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest struct {
 			Status *string `json:"status,omitempty"`
+			Users  *[]User `json:"users,omitempty"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
@@ -3002,25 +4568,22 @@ func ParseEntriesRestoreResponse(rsp *http.Response) (*EntriesRestoreResponse, e
 	return response, nil
 }
 
-// ParsePostFileEntriesStarResponse parses an HTTP response from a PostFileEntriesStarWithResponse call
-func ParsePostFileEntriesStarResponse(rsp *http.Response) (*PostFileEntriesStarResponse, error) {
+// ParseGetShareableLinkResponse parses an HTTP response from a GetShareableLinkWithResponse call
+func ParseGetShareableLinkResponse(rsp *http.Response) (*GetShareableLinkResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostFileEntriesStarResponse{
+	response := &GetShareableLinkResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest struct {
-			Status *string `json:"status,omitempty"`
-			Tag    *Tag    `json:"tag,omitempty"`
-		}
+		var dest ShareableLinkResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -3038,15 +4601,15 @@ func ParsePostFileEntriesStarResponse(rsp *http.Response) (*PostFileEntriesStarR
 	return response, nil
 }
 
-// ParsePostFileEntriesUnstarResponse parses an HTTP response from a PostFileEntriesUnstarWithResponse call
-func ParsePostFileEntriesUnstarResponse(rsp *http.Response) (*PostFileEntriesUnstarResponse, error) {
+// ParseCreateShareableLinkResponse parses an HTTP response from a CreateShareableLinkWithResponse call
+func ParseCreateShareableLinkResponse(rsp *http.Response) (*CreateShareableLinkResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostFileEntriesUnstarResponse{
+	response := &CreateShareableLinkResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -3054,8 +4617,8 @@ func ParsePostFileEntriesUnstarResponse(rsp *http.Response) (*PostFileEntriesUns
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest struct {
-			Status *string `json:"status,omitempty"`
-			Tag    *Tag    `json:"tag,omitempty"`
+			Link   *ShareableLink `json:"link,omitempty"`
+			Status *string        `json:"status,omitempty"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
@@ -3074,15 +4637,15 @@ func ParsePostFileEntriesUnstarResponse(rsp *http.Response) (*PostFileEntriesUns
 	return response, nil
 }
 
-// ParseEntryUpdateResponse parses an HTTP response from a EntryUpdateWithResponse call
-func ParseEntryUpdateResponse(rsp *http.Response) (*EntryUpdateResponse, error) {
+// ParseDeleteFileEntriesEntryIdUnshareResponse parses an HTTP response from a DeleteFileEntriesEntryIdUnshareWithResponse call
+func ParseDeleteFileEntriesEntryIdUnshareResponse(rsp *http.Response) (*DeleteFileEntriesEntryIdUnshareResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &EntryUpdateResponse{
+	response := &DeleteFileEntriesEntryIdUnshareResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -3090,8 +4653,8 @@ func ParseEntryUpdateResponse(rsp *http.Response) (*EntryUpdateResponse, error)
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest struct {
-			FileEntry *FileEntry `json:"fileEntry,omitempty"`
-			Status    *string    `json:"status,omitempty"`
+			Status *string `json:"status,omitempty"`
+			Users  *[]User `json:"users,omitempty"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
@@ -3110,15 +4673,15 @@ func ParseEntryUpdateResponse(rsp *http.Response) (*EntryUpdateResponse, error)
 	return response, nil
 }
 
-// ParsePutFileEntriesEntryIdChangePermissionsResponse parses an HTTP response from a PutFileEntriesEntryIdChangePermissionsWithResponse call
-func ParsePutFileEntriesEntryIdChangePermissionsResponse(rsp *http.Response) (*PutFileEntriesEntryIdChangePermissionsResponse, error) {
+// ParseDeleteShareableLinkResponse parses an HTTP response from a DeleteShareableLinkWithResponse call
+func ParseDeleteShareableLinkResponse(rsp *http.Response) (*DeleteShareableLinkResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PutFileEntriesEntryIdChangePermissionsResponse{
+	response := &DeleteShareableLinkResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -3127,7 +4690,6 @@ func ParsePutFileEntriesEntryIdChangePermissionsResponse(rsp *http.Response) (*P
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest struct {
 			Status *string `json:"status,omitempty"`
-			Users  *[]User `json:"users,omitempty"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
@@ -3146,15 +4708,15 @@ func ParsePutFileEntriesEntryIdChangePermissionsResponse(rsp *http.Response) (*P
 	return response, nil
 }
 
-// ParsePostFileEntriesEntryIdShareResponse parses an HTTP response from a PostFileEntriesEntryIdShareWithResponse call
-func ParsePostFileEntriesEntryIdShareResponse(rsp *http.Response) (*PostFileEntriesEntryIdShareResponse, error) {
+// ParseUpdateShareableLinkResponse parses an HTTP response from a UpdateShareableLinkWithResponse call
+func ParseUpdateShareableLinkResponse(rsp *http.Response) (*UpdateShareableLinkResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostFileEntriesEntryIdShareResponse{
+	response := &UpdateShareableLinkResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -3162,8 +4724,8 @@ func ParsePostFileEntriesEntryIdShareResponse(rsp *http.Response) (*PostFileEntr
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest struct {
-			Status *string `json:"status,omitempty"`
-			Users  *[]User `json:"users,omitempty"`
+			Link   *ShareableLink `json:"link,omitempty"`
+			Status *string        `json:"status,omitempty"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
@@ -3182,22 +4744,25 @@ func ParsePostFileEntriesEntryIdShareResponse(rsp *http.Response) (*PostFileEntr
 	return response, nil
 }
 
-// ParseGetShareableLinkResponse parses an HTTP response from a GetShareableLinkWithResponse call
-func ParseGetShareableLinkResponse(rsp *http.Response) (*GetShareableLinkResponse, error) {
+// ParseCreateFolderResponse parses an HTTP response from a CreateFolderWithResponse call
+func ParseCreateFolderResponse(rsp *http.Response) (*CreateFolderResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetShareableLinkResponse{
+	response := &CreateFolderResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ShareableLinkResponse
+		var dest struct {
+			Folder *FileEntry `json:"folder,omitempty"`
+			Status *string    `json:"status,omitempty"`
+		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -3215,65 +4780,71 @@ func ParseGetShareableLinkResponse(rsp *http.Response) (*GetShareableLinkRespons
 	return response, nil
 }
 
-// ParseCreateShareableLinkResponse parses an HTTP response from a CreateShareableLinkWithResponse call
-func ParseCreateShareableLinkResponse(rsp *http.Response) (*CreateShareableLinkResponse, error) {
+// ParseShowShareableLinkResponse parses an HTTP response from a ShowShareableLinkWithResponse call
+func ParseShowShareableLinkResponse(rsp *http.Response) (*ShowShareableLinkResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateShareableLinkResponse{
+	response := &ShowShareableLinkResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest struct {
-			Link   *ShareableLink `json:"link,omitempty"`
-			Status *string        `json:"status,omitempty"`
-		}
+		var dest ShareableLinkResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
-		var dest N422Response
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON422 = &dest
+	}
+
+	return response, nil
+}
+
+// ParseDownloadShareableLinkResponse parses an HTTP response from a DownloadShareableLinkWithResponse call
+func ParseDownloadShareableLinkResponse(rsp *http.Response) (*DownloadShareableLinkResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
 
+	response := &DownloadShareableLinkResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
 
 	return response, nil
 }
 
-// ParseDeleteFileEntriesEntryIdUnshareResponse parses an HTTP response from a DeleteFileEntriesEntryIdUnshareWithResponse call
-func ParseDeleteFileEntriesEntryIdUnshareResponse(rsp *http.Response) (*DeleteFileEntriesEntryIdUnshareResponse, error) {
+// ParseTusEntryResponse parses an HTTP response from a TusEntryWithResponse call
+func ParseTusEntryResponse(rsp *http.Response) (*TusEntryResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteFileEntriesEntryIdUnshareResponse{
+	response := &TusEntryResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
 		var dest struct {
-			Status *string `json:"status,omitempty"`
-			Users  *[]User `json:"users,omitempty"`
+			FileEntry *FileEntry `json:"fileEntry,omitempty"`
+			Status    *string    `json:"status,omitempty"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON201 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
 		var dest N422Response
@@ -3287,28 +4858,77 @@ func ParseDeleteFileEntriesEntryIdUnshareResponse(rsp *http.Response) (*DeleteFi
 	return response, nil
 }
 
-// ParseDeleteShareableLinkResponse parses an HTTP response from a DeleteShareableLinkWithResponse call
-func ParseDeleteShareableLinkResponse(rsp *http.Response) (*DeleteShareableLinkResponse, error) {
+// ParseTusCreateResponse parses an HTTP response from a TusCreateWithResponse call
+func ParseTusCreateResponse(rsp *http.Response) (*TusCreateResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteShareableLinkResponse{
+	response := &TusCreateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseTusOffsetResponse parses an HTTP response from a TusOffsetWithResponse call
+func ParseTusOffsetResponse(rsp *http.Response) (*TusOffsetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &TusOffsetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseTusPatchResponse parses an HTTP response from a TusPatchWithResponse call
+func ParseTusPatchResponse(rsp *http.Response) (*TusPatchResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &TusPatchResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseUploadResponse parses an HTTP response from a UploadWithResponse call
+func ParseUploadResponse(rsp *http.Response) (*UploadResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UploadResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
 		var dest struct {
-			Status *string `json:"status,omitempty"`
+			FileEntry *FileEntry `json:"fileEntry,omitempty"`
+			Status    *string    `json:"status,omitempty"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON201 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
 		var dest N422Response
@@ -3322,15 +4942,15 @@ func ParseDeleteShareableLinkResponse(rsp *http.Response) (*DeleteShareableLinkR
 	return response, nil
 }
 
-// ParseUpdateShareableLinkResponse parses an HTTP response from a UpdateShareableLinkWithResponse call
-func ParseUpdateShareableLinkResponse(rsp *http.Response) (*UpdateShareableLinkResponse, error) {
+// ParseUploadConfigResponse parses an HTTP response from a UploadConfigWithResponse call
+func ParseUploadConfigResponse(rsp *http.Response) (*UploadConfigResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateShareableLinkResponse{
+	response := &UploadConfigResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -3338,35 +4958,29 @@ func ParseUpdateShareableLinkResponse(rsp *http.Response) (*UpdateShareableLinkR
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest struct {
-			Link   *ShareableLink `json:"link,omitempty"`
-			Status *string        `json:"status,omitempty"`
+			// MaxSize Maximum size of a single uploaded file in bytes, or 0 if unlimited
+			MaxSize *int64  `json:"max_size,omitempty"`
+			Status  *string `json:"status,omitempty"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
-		var dest N422Response
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON422 = &dest
-
 	}
 
 	return response, nil
 }
 
-// ParseCreateFolderResponse parses an HTTP response from a CreateFolderWithResponse call
-func ParseCreateFolderResponse(rsp *http.Response) (*CreateFolderResponse, error) {
+// ParseCurrentUserResponse parses an HTTP response from a CurrentUserWithResponse call
+func ParseCurrentUserResponse(rsp *http.Response) (*CurrentUserResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateFolderResponse{
+	response := &CurrentUserResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -3374,56 +4988,54 @@ func ParseCreateFolderResponse(rsp *http.Response) (*CreateFolderResponse, error
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest struct {
-			Folder *FileEntry `json:"folder,omitempty"`
-			Status *string    `json:"status,omitempty"`
+			// AvailableSpace Total storage allowed for the user in bytes, or 0 if unlimited
+			AvailableSpace *int64 `json:"available_space,omitempty"`
+
+			// MaxFileSize Maximum size of a single uploaded file in bytes, or 0 if unlimited
+			MaxFileSize *int64 `json:"max_file_size,omitempty"`
+
+			// Plan Name of the user's subscription plan
+			Plan   *string `json:"plan,omitempty"`
+			Status *string `json:"status,omitempty"`
+			User   *User   `json:"user,omitempty"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
-		var dest N422Response
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON422 = &dest
-
 	}
 
 	return response, nil
 }
 
-// ParseUploadResponse parses an HTTP response from a UploadWithResponse call
-func ParseUploadResponse(rsp *http.Response) (*UploadResponse, error) {
+// ParseSpaceUsageResponse parses an HTTP response from a SpaceUsageWithResponse call
+func ParseSpaceUsageResponse(rsp *http.Response) (*SpaceUsageResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UploadResponse{
+	response := &SpaceUsageResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest struct {
-			FileEntry *FileEntry `json:"fileEntry,omitempty"`
-			Status    *string    `json:"status,omitempty"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
+			// Available Total storage allowed for the current user in bytes, or 0 if unlimited
+			Available *int64  `json:"available,omitempty"`
+			Status    *string `json:"status,omitempty"`
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
-		var dest N422Response
+			// Used Storage used by the current user in bytes
+			Used *int64 `json:"used,omitempty"`
+		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON422 = &dest
+		response.JSON200 = &dest
 
 	}
 
@@ -0,0 +1,26 @@
+package folderfort
+
+import "errors"
+
+// ErrClientClosed is returned by every call made with a client after Close.
+var ErrClientClosed = errors.New("folderfort: client is closed")
+
+// Close releases the resources held by a client created by
+// NewClientWithAPIToken: it closes the idle connections of the client's own
+// connection pool and forgets cached folder IDs. Calls in progress are not
+// interrupted, but the client is unusable afterwards, and every later call
+// fails with ErrClientClosed. Transports supplied with WithTransport or
+// WithAuthenticatedHTTPClient are left open, since they may be shared.
+// Calling Close more than once has no further effect.
+func (c *Client) Close() error {
+	d, ok := c.Client.(*doerWithToken)
+	if !ok || d.closed.Swap(true) {
+		return nil
+	}
+	d.transport()
+	if d.ownTransport != nil {
+		d.ownTransport.CloseIdleConnections()
+	}
+	d.folders.clear()
+	return nil
+}
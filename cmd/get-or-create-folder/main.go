@@ -26,6 +26,7 @@ const (
 
 var (
 	baseURL    = flag.String("url", "https://na.folderfort.com/api/v1", "FolderFort base API URL")
+	region     = flag.String("region", "", "FolderFort region (such as na); overrides -url")
 	debug      = flag.Bool("debug", true, "Debug API calls")
 	folderName = flag.String("folder", "", "Name of folder to get or create on FolderFort")
 )
@@ -42,6 +43,11 @@ func main() {
 	if *folderName == "" {
 		log.Fatalf("Missing -folder flag")
 	}
+	if *region != "" {
+		if *baseURL = folderfort.BaseURLForRegion(folderfort.Region(*region)); *baseURL == "" {
+			log.Fatalf("Unknown region %q", *region)
+		}
+	}
 
 	log.Printf("Using API URL: %v\n", *baseURL)
 
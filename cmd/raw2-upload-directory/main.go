@@ -33,6 +33,7 @@ const (
 
 var (
 	baseURL    = flag.String("url", "https://na.folderfort.com/api/v1", "FolderFort base API URL")
+	region     = flag.String("region", "", "FolderFort region (such as na); overrides -url")
 	debug      = flag.Bool("debug", true, "Debug API calls")
 	dirName    = flag.String("dir", ".", "Directory to upload to FolderFort")
 	folderName = flag.String("folder", "", "Optional name of new folder to create on FolderFort")
@@ -46,6 +47,11 @@ type client struct {
 func main() {
 	log.SetFlags(0)
 	flag.Parse()
+	if *region != "" {
+		if *baseURL = folderfort.BaseURLForRegion(folderfort.Region(*region)); *baseURL == "" {
+			log.Fatalf("Unknown region %q", *region)
+		}
+	}
 	log.Printf("Using API URL: %v\n", *baseURL)
 
 	apiToken := strings.TrimSpace(os.Getenv(tokenEnvVar))
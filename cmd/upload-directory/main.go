@@ -12,10 +12,12 @@ package main
 
 import (
 	"context"
+	"errors"
 	"flag"
 	"log"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/gmlewis/go-folderfort"
 )
@@ -26,9 +28,17 @@ const (
 
 var (
 	baseURL    = flag.String("url", "https://na.folderfort.com/api/v1", "FolderFort base API URL")
+	region     = flag.String("region", "", "FolderFort region (such as na); overrides -url")
 	debug      = flag.Bool("debug", true, "Debug API calls")
 	dirName    = flag.String("dir", ".", "Directory to upload to FolderFort")
 	folderName = flag.String("folder", "", "Optional name of new folder to create on FolderFort")
+	fileDelay  = flag.Duration("upload-delay", 500*time.Millisecond, "Pause after each file upload")
+	mkdirDelay = flag.Duration("folder-delay", 0, "Pause before each folder creation")
+	noDotfiles = flag.Bool("no-dotfiles", false, "Skip all files and folders whose names start with '.'")
+	workers    = flag.Int("concurrency", 1, "Number of files to upload in parallel")
+	skipSame   = flag.Bool("skip-unchanged", false, "Skip files that are already on FolderFort with the same name and size and a modification time no older than the local file")
+	rps        = flag.Float64("rate", 0, "Maximum API requests per second (0 for no limit); replaces -upload-delay")
+	bandwidth  = flag.Int64("bandwidth", 0, "Maximum upload bytes per second (0 for no limit)")
 )
 
 type client struct {
@@ -39,15 +49,39 @@ type client struct {
 func main() {
 	log.SetFlags(0)
 	flag.Parse()
+	if *region != "" {
+		if *baseURL = folderfort.BaseURLForRegion(folderfort.Region(*region)); *baseURL == "" {
+			log.Fatalf("Unknown region %q", *region)
+		}
+	}
 	log.Printf("Using API URL: %v\n", *baseURL)
 
 	apiToken := strings.TrimSpace(os.Getenv(tokenEnvVar))
 	if apiToken == "" {
 		log.Fatalf("Missing %q env var", tokenEnvVar)
 	}
-	fc, err := folderfort.NewClientWithAPIToken(*baseURL, apiToken, *debug)
+	opts := []folderfort.ClientOption{
+		folderfort.WithUploadDelay(*fileDelay),
+		folderfort.WithFolderCreationDelay(*mkdirDelay),
+		folderfort.WithConcurrency(*workers),
+		folderfort.WithPrintfLogger(log.Default()),
+	}
+	if *rps > 0 {
+		opts = append(opts, folderfort.WithRateLimit(*rps, 1))
+	}
+	if *bandwidth > 0 {
+		opts = append(opts, folderfort.WithUploadBandwidthLimit(*bandwidth))
+	}
+	fc, err := folderfort.NewClientWithAPIToken(*baseURL, apiToken, *debug, opts...)
 	must(err)
 	ctx := context.Background()
+	// A rejected token would fail every upload, but other ping failures
+	// (such as a server without the user endpoint) need not.
+	if err := fc.Ping(ctx); errors.Is(err, folderfort.ErrUnauthorized) {
+		log.Fatalf("Unable to use FolderFort at %v: %v", *baseURL, err)
+	} else if err != nil {
+		log.Printf("Warning: unable to ping FolderFort at %v: %v (continuing)", *baseURL, err)
+	}
 
 	log.Printf("Uploading directory: %v\n", *dirName)
 
@@ -60,7 +94,11 @@ func main() {
 	}
 
 	// Start uploading
-	fc.UploadDirectory(ctx, *dirName, parentID, nil)
+	stats, err := fc.UploadDirectoryWithOptions(ctx, *dirName, parentID, &folderfort.UploadOptions{ExcludeDotfiles: *noDotfiles, SkipUnchanged: *skipSame})
+	if err != nil {
+		log.Fatalf("Failed to upload directory: %v", err)
+	}
+	log.Printf("Uploaded %v files and folders.", len(stats.IDs))
 
 	log.Printf("Done.")
 }
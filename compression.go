@@ -0,0 +1,51 @@
+package folderfort
+
+import "strings"
+
+// WithUploadCompression gzips the content of uploaded files, marking the file
+// part of the request with "Content-Encoding: gzip". Files whose MIME type is
+// already compressed (most images, audio and video, archives and PDFs) are
+// sent as they are. The size limit of WithMaxFileSize still applies to the
+// uncompressed content, and UploadReaderSized can no longer stream a
+// compressed file since its compressed length is unknown.
+// Only enable this if the server decompresses uploads on ingest; otherwise
+// the stored files will be gzipped. The default is off.
+func WithUploadCompression(enabled bool) ClientOption {
+	return withDoer(func(d *doerWithToken) error {
+		d.compressUploads = enabled
+		return nil
+	})
+}
+
+// compressedMIMETypes are MIME types whose content is already compressed.
+var compressedMIMETypes = map[string]bool{
+	"application/gzip":             true,
+	"application/pdf":              true,
+	"application/vnd.rar":          true,
+	"application/x-7z-compressed":  true,
+	"application/x-bzip2":          true,
+	"application/x-gzip":           true,
+	"application/x-rar-compressed": true,
+	"application/x-xz":             true,
+	"application/zip":              true,
+	"application/zstd":             true,
+	"font/woff":                    true,
+	"font/woff2":                   true,
+}
+
+// compressible reports whether content of the given MIME type is worth gzipping.
+func compressible(mimeType string) bool {
+	mediaType, _, _ := strings.Cut(strings.ToLower(mimeType), ";")
+	mediaType = strings.TrimSpace(mediaType)
+	if compressedMIMETypes[mediaType] {
+		return false
+	}
+	switch major, minor, _ := strings.Cut(mediaType, "/"); major {
+	case "image":
+		// Uncompressed or text-based image formats still shrink well.
+		return minor == "svg+xml" || minor == "bmp" || minor == "x-ms-bmp" || minor == "tiff"
+	case "audio", "video":
+		return minor == "wav" || minor == "x-wav"
+	}
+	return true
+}
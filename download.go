@@ -0,0 +1,241 @@
+package folderfort
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// ErrRangeNotSupported is returned by DownloadRange when the server ignores
+// the Range header and responds with the full file (200) instead of 206.
+var ErrRangeNotSupported = errors.New("server ignored the Range header")
+
+// errRangeNotSatisfiable is returned when the server responds 416, typically
+// because the requested start is at or beyond the end of the file.
+var errRangeNotSatisfiable = errors.New("requested range not satisfiable")
+
+// download streams the contents of entryID into w and returns the number of bytes written.
+// If rangeHeader is non-empty, it is sent as the Range header and a 206 response is required.
+func (c *Client) download(ctx context.Context, entryID int64, rangeHeader string, w io.Writer) (int64, error) {
+	params := &DownloadEntryParams{}
+	if rangeHeader != "" {
+		params.Range = &rangeHeader
+	}
+
+	resp, err := c.DownloadEntry(ctx, entryID, params)
+	if err != nil {
+		return 0, fmt.Errorf("c.DownloadEntry: %w", err)
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case rangeHeader == "" && resp.StatusCode == 200:
+	case rangeHeader != "" && resp.StatusCode == 206:
+	case rangeHeader != "" && resp.StatusCode == 200:
+		return 0, ErrRangeNotSupported
+	case resp.StatusCode == 416:
+		return 0, errRangeNotSatisfiable
+	default:
+		body, _ := io.ReadAll(resp.Body)
+		return 0, newAPIError(resp.StatusCode, body)
+	}
+
+	n, err := c.copyBuffer(w, resp.Body)
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			err = ctxErr
+		}
+		return n, fmt.Errorf("failed to download entry %v: %w", entryID, err)
+	}
+	return n, nil
+}
+
+// Download streams the contents of the file entryID into w and returns the
+// number of bytes written. A non-200 response is returned as an *APIError.
+// Cancelling ctx aborts the download, even part way through the body.
+func (c *Client) Download(ctx context.Context, entryID int64, w io.Writer) (int64, error) {
+	return c.download(ctx, entryID, "", w)
+}
+
+// DownloadRange streams bytes start through end (inclusive) of the file entryID into w.
+// A negative end downloads from start to the end of the file.
+// It returns ErrRangeNotSupported if the server ignores the requested range.
+func (c *Client) DownloadRange(ctx context.Context, entryID int64, start, end int64, w io.Writer) error {
+	if start < 0 || (end >= 0 && end < start) {
+		return fmt.Errorf("invalid range %v-%v", start, end)
+	}
+
+	rangeHeader := fmt.Sprintf("bytes=%v-", start)
+	if end >= 0 {
+		rangeHeader += fmt.Sprintf("%v", end)
+	}
+
+	_, err := c.download(ctx, entryID, rangeHeader, w)
+	return err
+}
+
+// DownloadFileToPath downloads the file entryID to destPath.
+// If destPath already exists, the download resumes from the end of the local
+// file; if the server does not support ranges, the file is downloaded again
+// from the start. A local file that is already complete is left unchanged,
+// but one larger than the remote file is downloaded again.
+func (c *Client) DownloadFileToPath(ctx context.Context, entryID int64, destPath string) error {
+	var offset int64
+	if info, err := os.Stat(destPath); err == nil {
+		offset = info.Size()
+	}
+
+	if offset > 0 {
+		f, err := os.OpenFile(destPath, os.O_WRONLY|os.O_APPEND, 0644)
+		if err != nil {
+			return fmt.Errorf("error opening file %v: %w", destPath, err)
+		}
+		err = c.DownloadRange(ctx, entryID, offset, -1, f)
+		if cerr := f.Close(); err == nil && cerr != nil {
+			return fmt.Errorf("error closing file %v: %w", destPath, cerr)
+		}
+		switch {
+		case err == nil:
+			return nil
+		case errors.Is(err, errRangeNotSatisfiable):
+			// The local file is at least as long as the remote one, but it
+			// is only complete if the sizes match.
+			entry, err := c.GetEntry(ctx, entryID)
+			if err != nil {
+				return err
+			}
+			if entry.Size == offset {
+				return nil
+			}
+		case !errors.Is(err, ErrRangeNotSupported):
+			return err
+		}
+		// The server ignored the range or the local file does not match, so start over.
+	}
+
+	return c.DownloadToPath(ctx, entryID, destPath)
+}
+
+// DownloadToPath downloads the file entryID to destPath, replacing any existing file.
+// The contents are written to a temporary file in the same directory that is
+// renamed to destPath once complete, so a failed download leaves neither a
+// partial file nor a damaged existing one behind.
+func (c *Client) DownloadToPath(ctx context.Context, entryID int64, destPath string) error {
+	f, err := os.CreateTemp(filepath.Dir(destPath), "."+filepath.Base(destPath)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("error creating file %v: %w", destPath, err)
+	}
+	tmpPath := f.Name()
+	defer os.Remove(tmpPath) // no-op once renamed
+
+	if _, err := c.download(ctx, entryID, "", f); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Chmod(0644); err != nil {
+		f.Close()
+		return fmt.Errorf("error setting mode of file %v: %w", destPath, err)
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("error closing file %v: %w", destPath, err)
+	}
+	if err := os.Rename(tmpPath, destPath); err != nil {
+		return fmt.Errorf("error renaming file to %v: %w", destPath, err)
+	}
+	return nil
+}
+
+// DownloadOptions configures DownloadDirectoryWithOptions.
+type DownloadOptions struct {
+	// ApplyMetadata restores the file modes and modification times recorded
+	// by UploadOptions.WriteMetadata, if the tree contains a MetadataFileName file.
+	ApplyMetadata bool
+
+	// ExcludePatterns skips any entry whose local destination path contains
+	// one of these substrings, as UploadOptions.ExcludePatterns does for uploads.
+	ExcludePatterns []string
+}
+
+// DownloadDirectory downloads the contents of the folder folderID (or the
+// root folder if nil) into the local directory destPath, recreating the
+// folder structure and skipping any entry whose local path contains one of
+// excludePatterns. It stops at the first error.
+func (c *Client) DownloadDirectory(ctx context.Context, folderID *int64, destPath string, excludePatterns []string) error {
+	_, err := c.DownloadDirectoryWithOptions(ctx, folderID, destPath, &DownloadOptions{ExcludePatterns: excludePatterns})
+	return err
+}
+
+// DownloadDirectoryWithOptions downloads the contents of the folder folderID
+// (or the root folder if nil) into the local directory destPath, recreating
+// the folder structure. A nil opts uses the defaults.
+// The returned Stats are populated even when an error is returned part way through.
+func (c *Client) DownloadDirectoryWithOptions(ctx context.Context, folderID *int64, destPath string, opts *DownloadOptions) (*Stats, error) {
+	if opts == nil {
+		opts = &DownloadOptions{}
+	}
+
+	stats := &Stats{IDs: map[string]int64{}}
+	if err := c.downloadDirectory(ctx, folderID, destPath, "", opts, stats); err != nil {
+		return stats, err
+	}
+
+	if _, ok := stats.IDs[MetadataFileName]; ok && opts.ApplyMetadata {
+		if err := ApplyMetadata(destPath); err != nil {
+			return stats, fmt.Errorf("failed to apply metadata: %w", err)
+		}
+	}
+
+	return stats, nil
+}
+
+// checkSafeName returns an error if the name of e, which comes from the
+// server, could not be used as a single local path element without
+// escaping the directory it is written to.
+func checkSafeName(e Entry) error {
+	if e.Name == "" || e.Name == "." || e.Name == ".." || strings.ContainsAny(e.Name, `/\`) {
+		return fmt.Errorf("refusing to download entry %v with unsafe name %q", e.ID, e.Name)
+	}
+	return nil
+}
+
+// downloadDirectory downloads the folder folderID, whose path relative to the
+// top-level folder is relPath, into the local directory destPath.
+func (c *Client) downloadDirectory(ctx context.Context, folderID *int64, destPath, relPath string, opts *DownloadOptions, stats *Stats) error {
+	if err := os.MkdirAll(destPath, 0755); err != nil {
+		return fmt.Errorf("error creating directory %v: %w", destPath, err)
+	}
+
+	entries, err := c.listFolder(ctx, folderID)
+	if err != nil {
+		return err
+	}
+
+	for _, e := range entries {
+		if err := checkSafeName(e); err != nil {
+			return err
+		}
+		itemPath := filepath.Join(destPath, e.Name)
+		itemRelPath := path.Join(relPath, e.Name)
+
+		if shouldExclude(itemPath, opts.ExcludePatterns) {
+			stats.skip(itemRelPath, SkippedExcluded)
+			continue
+		}
+
+		if e.IsFolder() {
+			if err := c.downloadDirectory(ctx, Ptr(e.ID), itemPath, itemRelPath, opts, stats); err != nil {
+				return err
+			}
+		} else if err := c.DownloadToPath(ctx, e.ID, itemPath); err != nil {
+			return err
+		}
+		stats.IDs[itemRelPath] = e.ID
+	}
+
+	return nil
+}
@@ -0,0 +1,510 @@
+package folderfort
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"maps"
+	"math"
+	"net/http"
+	"path"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Entry is a file or folder stored on FolderFort.
+type Entry struct {
+	ID       int64  `json:"id"`
+	Name     string `json:"name"`
+	FileName string `json:"file_name"`
+	// ParentID is the ID of the containing folder, or nil for the root folder.
+	ParentID *int64 `json:"parent_id"`
+	// Path lists the IDs of the parent folders up to the root.
+	Path string        `json:"path"`
+	Type FileEntryType `json:"type"`
+	Size int64         `json:"file_size"`
+	MIME string        `json:"mime"`
+	// URL is the server-relative URL for previewing the entry's contents.
+	URL string `json:"url"`
+	// Description is the entry's description, if any; see SetEntryDescription.
+	Description string    `json:"description"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+	// ProcessingStatus is the server's processing state of a newly uploaded
+	// file (e.g. "processing", "ready" or "failed"), or empty if none applies.
+	ProcessingStatus string `json:"processing_status"`
+}
+
+// IsFolder reports whether e is a folder.
+func (e *Entry) IsFolder() bool {
+	return e.Type == FileEntryTypeFolder
+}
+
+// IsFile reports whether e is a file of any type, such as an image or a PDF.
+func (e *Entry) IsFile() bool {
+	return e.Type != "" && e.Type != FileEntryTypeFolder
+}
+
+type showEntryResponse struct {
+	FileEntry Entry `json:"fileEntry"`
+}
+
+// GetEntry fetches the metadata of the single entry entryID.
+// If there is no such entry, the error wraps ErrEntryNotFound.
+func (c *Client) GetEntry(ctx context.Context, entryID int64) (*Entry, error) {
+	resp, err := c.ShowEntry(ctx, entryID)
+	if err != nil {
+		return nil, fmt.Errorf("c.ShowEntry: %w", err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("io.ReadAll: %w", err)
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("failed to get entry %v: %w: %w", entryID, ErrEntryNotFound, newAPIError(resp.StatusCode, body))
+	}
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("failed to get entry %v: %w", entryID, newAPIError(resp.StatusCode, body))
+	}
+	if err := checkEnvelope(resp.StatusCode, body); err != nil {
+		return nil, err
+	}
+
+	var entryResp showEntryResponse
+	if err := json.Unmarshal(body, &entryResp); err != nil {
+		return nil, fmt.Errorf("failed to parse entry response: %w\n%s", err, body)
+	}
+
+	return &entryResp.FileEntry, nil
+}
+
+// entriesPerPage is the page size requested when listing entries.
+const entriesPerPage = 100
+
+type indexEntryPageResponse struct {
+	CurrentPage int64   `json:"current_page"`
+	LastPage    int64   `json:"last_page"`
+	Total       int64   `json:"total"`
+	Data        []Entry `json:"data"`
+}
+
+// indexEntryPage fetches a single page of IndexEntry results.
+func (c *Client) indexEntryPage(ctx context.Context, params *IndexEntryParams) (*indexEntryPageResponse, error) {
+	if params.WorkspaceId == nil {
+		if ws := c.doer().workspaceID; ws != nil {
+			params.WorkspaceId = Ptr(int(*ws))
+		}
+	}
+
+	resp, err := c.IndexEntry(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("c.IndexEntry: %w", err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("io.ReadAll: %w", err)
+	}
+
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("failed to list entries: %w", newAPIError(resp.StatusCode, body))
+	}
+	if err := checkEnvelope(resp.StatusCode, body); err != nil {
+		return nil, err
+	}
+
+	var pageResp indexEntryPageResponse
+	if err := json.Unmarshal(body, &pageResp); err != nil {
+		return nil, fmt.Errorf("failed to parse entries response: %w\n%s", err, body)
+	}
+
+	return &pageResp, nil
+}
+
+// listEntries returns every entry matching params, following pagination
+// until the last page has been read.
+func (c *Client) listEntries(ctx context.Context, params IndexEntryParams) ([]Entry, error) {
+	if params.PerPage == nil {
+		params.PerPage = Ptr(int64(entriesPerPage))
+	}
+
+	var results []Entry
+	for page := int64(1); ; page++ {
+		params.Page = Ptr(page)
+		pageResp, err := c.indexEntryPage(ctx, &params)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, pageResp.Data...)
+		if len(pageResp.Data) == 0 || page >= pageResp.LastPage {
+			return results, nil
+		}
+	}
+}
+
+// listFolder returns the immediate children of the folder parentID (or the root folder if nil).
+// Since the API does not reliably honor ParentIds, the results are also filtered here.
+func (c *Client) listFolder(ctx context.Context, parentID *int64) ([]Entry, error) {
+	return c.listFolderWithParams(ctx, parentID, IndexEntryParams{})
+}
+
+// listFolderWithParams is listFolder with additional filters in params.
+func (c *Client) listFolderWithParams(ctx context.Context, parentID *int64, params IndexEntryParams) ([]Entry, error) {
+	if parentID != nil {
+		params.ParentIds = &[]string{fmt.Sprintf("%v", *parentID)}
+	}
+
+	entries, err := c.listEntries(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	results := entries[:0]
+	for _, e := range entries {
+		if sameParent(e.ParentID, parentID) {
+			results = append(results, e)
+		}
+	}
+	return results, nil
+}
+
+// ListOption narrows the entries returned by ListEntries.
+type ListOption func(*IndexEntryParams)
+
+// ListType only returns entries of the given type, such as IndexEntryParamsTypeFolder.
+func ListType(typ IndexEntryParamsType) ListOption {
+	return func(p *IndexEntryParams) { p.Type = &typ }
+}
+
+// ListQuery only returns entries whose names match query.
+func ListQuery(query string) ListOption {
+	return func(p *IndexEntryParams) { p.Query = &query }
+}
+
+// ListStarredOnly only returns starred entries.
+func ListStarredOnly() ListOption {
+	return func(p *IndexEntryParams) { p.StarredOnly = Ptr(true) }
+}
+
+// ListDeletedOnly only returns entries in the trash.
+func ListDeletedOnly() ListOption {
+	return func(p *IndexEntryParams) { p.DeletedOnly = Ptr(true) }
+}
+
+// ListWorkspace only returns entries in the workspace id, overriding
+// WithWorkspace for this call. Use 0 for the personal space.
+func ListWorkspace(id int64) ListOption {
+	return func(p *IndexEntryParams) { p.WorkspaceId = Ptr(int(id)) }
+}
+
+// ListPerPage sets how many entries are requested per page. It does not
+// limit the total returned. The default is 100.
+func ListPerPage(n int64) ListOption {
+	return func(p *IndexEntryParams) { p.PerPage = &n }
+}
+
+// ListEntries returns every entry in the folder parentID (or the root folder
+// if nil), following pagination until all pages have been read.
+func (c *Client) ListEntries(ctx context.Context, parentID *int64, opts ...ListOption) ([]Entry, error) {
+	var params IndexEntryParams
+	for _, opt := range opts {
+		opt(&params)
+	}
+	if err := validateEntryType(params.Type); err != nil {
+		return nil, err
+	}
+	if params.PerPage != nil && *params.PerPage <= 0 {
+		return nil, fmt.Errorf("invalid page size %v", *params.PerPage)
+	}
+	return c.listFolderWithParams(ctx, parentID, params)
+}
+
+// ListEntriesStream is like ListEntries but fetches one page at a time as the
+// returned sequence is consumed, so very large folders need not be held in
+// memory. If a page cannot be fetched (including because ctx is done), the
+// error is yielded once and the sequence ends. Stopping early fetches no more pages.
+func (c *Client) ListEntriesStream(ctx context.Context, parentID *int64, opts ...ListOption) iter.Seq2[Entry, error] {
+	return func(yield func(Entry, error) bool) {
+		var params IndexEntryParams
+		for _, opt := range opts {
+			opt(&params)
+		}
+		if err := validateEntryType(params.Type); err != nil {
+			yield(Entry{}, err)
+			return
+		}
+		if params.PerPage == nil {
+			params.PerPage = Ptr(int64(entriesPerPage))
+		}
+		if parentID != nil {
+			params.ParentIds = &[]string{fmt.Sprintf("%v", *parentID)}
+		}
+
+		for page := int64(1); ; page++ {
+			if err := ctx.Err(); err != nil {
+				yield(Entry{}, err)
+				return
+			}
+			params.Page = Ptr(page)
+			pageResp, err := c.indexEntryPage(ctx, &params)
+			if err != nil {
+				yield(Entry{}, err)
+				return
+			}
+			for _, e := range pageResp.Data {
+				if sameParent(e.ParentID, parentID) && !yield(e, nil) {
+					return
+				}
+			}
+			if len(pageResp.Data) == 0 || page >= pageResp.LastPage {
+				return
+			}
+		}
+	}
+}
+
+// sameParent reports whether two parent folder IDs refer to the same folder.
+func sameParent(a, b *int64) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return *a == *b
+}
+
+// toInt32IDs converts entry IDs to the int32 form used by some API request bodies.
+func toInt32IDs(ids []int64) ([]int32, error) {
+	results := make([]int32, 0, len(ids))
+	for _, id := range ids {
+		if id < 0 || id > math.MaxInt32 {
+			return nil, fmt.Errorf("entry ID %v out of range", id)
+		}
+		results = append(results, int32(id))
+	}
+	return results, nil
+}
+
+// MoveEntries moves entries by ID into the folder destinationParentID (or the root folder if nil).
+func (c *Client) MoveEntries(ctx context.Context, entryIDs []int64, destinationParentID *int64) error {
+	ids, err := toInt32IDs(entryIDs)
+	if err != nil {
+		return err
+	}
+	req := EntriesMoveJSONRequestBody{EntryIds: ids}
+	if destinationParentID != nil {
+		destIDs, err := toInt32IDs([]int64{*destinationParentID})
+		if err != nil {
+			return err
+		}
+		req.DestinationId = &destIDs[0]
+	}
+
+	// Moved folders keep their IDs but change parent, so drop any cached paths.
+	c.doer().folders.clear()
+
+	resp, err := c.EntriesMove(ctx, req)
+	if err != nil {
+		return fmt.Errorf("c.EntriesMove: %w", err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("io.ReadAll: %w", err)
+	}
+
+	if resp.StatusCode != 200 {
+		return fmt.Errorf("failed to move entries %+v: %w", entryIDs, newAPIError(resp.StatusCode, body))
+	}
+	if err := checkEnvelope(resp.StatusCode, body); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MoveByPath moves the file or folder at the slash-separated srcPath below
+// srcParent into the folder at destFolderPath below destParent (either
+// parent is the root folder if nil). The destination folders are created if
+// needed, as by GetOrCreateFolder; an empty destFolderPath moves the entry
+// directly into destParent. If nothing exists at srcPath, the error wraps
+// ErrEntryNotFound, and if several entries share its name, nothing is moved.
+func (c *Client) MoveByPath(ctx context.Context, srcPath, destFolderPath string, srcParent, destParent *int64) error {
+	srcDir, srcName := path.Split(strings.Trim(srcPath, "/"))
+	if srcName == "" {
+		return errors.New("source path must not be empty")
+	}
+	if srcDir != "" {
+		var err error
+		if srcParent, err = c.lookupFolderPath(ctx, srcDir, srcParent); err != nil {
+			return fmt.Errorf("failed to move %q: %w", srcPath, err)
+		}
+	}
+	ids, err := c.getEntriesByName(ctx, srcName, srcParent, nil)
+	if err != nil {
+		return fmt.Errorf("failed to move %q: %w", srcPath, err)
+	}
+	switch len(ids) {
+	case 0:
+		return fmt.Errorf("failed to move %q: %w", srcPath, ErrEntryNotFound)
+	case 1:
+	default:
+		return fmt.Errorf("failed to move %q: %v entries match %+v", srcPath, len(ids), ids)
+	}
+
+	if strings.Trim(destFolderPath, "/") != "" {
+		if destParent, err = c.GetOrCreateFolder(ctx, strings.Trim(destFolderPath, "/"), destParent); err != nil {
+			return fmt.Errorf("unable to create folder %q: %w", destFolderPath, err)
+		}
+	}
+	return c.MoveEntries(ctx, ids, destParent)
+}
+
+// StatPath returns the metadata of the file or folder at the slash-separated
+// path below parentID (or the root folder if nil), such as "a/b/c.txt",
+// resolving one folder at a time. Nothing is ever created. If any part of
+// the path does not exist, the error wraps ErrEntryNotFound; if several
+// entries share the final name, an error is returned rather than guessing.
+func (c *Client) StatPath(ctx context.Context, entryPath string, parentID *int64) (*Entry, error) {
+	dir, name := path.Split(strings.Trim(entryPath, "/"))
+	if name == "" {
+		return nil, errors.New("path must not be empty")
+	}
+	if dir != "" {
+		var err error
+		parentID, err = c.lookupFolderPath(ctx, dir, parentID)
+		if errors.Is(err, ErrParentNotFound) {
+			return nil, fmt.Errorf("failed to stat %q: %w: %w", entryPath, ErrEntryNotFound, err)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to stat %q: %w", entryPath, err)
+		}
+	}
+
+	entries, err := c.findEntriesByName(ctx, name, parentID, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to stat %q: %w", entryPath, err)
+	}
+	switch len(entries) {
+	case 0:
+		return nil, fmt.Errorf("failed to stat %q: %w", entryPath, ErrEntryNotFound)
+	case 1:
+		return &entries[0], nil
+	}
+	ids := make([]int64, 0, len(entries))
+	for _, e := range entries {
+		ids = append(ids, e.ID)
+	}
+	return nil, fmt.Errorf("failed to stat %q: %v entries match %+v", entryPath, len(entries), ids)
+}
+
+type entriesCopyResponse struct {
+	Entries []Entry `json:"entries"`
+}
+
+// CopyEntries duplicates entries by ID into the folder destinationParentID
+// (or the root folder if nil) and returns the new entries.
+// Folders are copied deeply by the server, including all of their contents,
+// but only the new top-level entries are returned.
+func (c *Client) CopyEntries(ctx context.Context, entryIDs []int64, destinationParentID *int64) ([]Entry, error) {
+	ids, err := toInt32IDs(entryIDs)
+	if err != nil {
+		return nil, err
+	}
+	req := EntriesCopyJSONRequestBody{EntryIds: ids}
+	if destinationParentID != nil {
+		destIDs, err := toInt32IDs([]int64{*destinationParentID})
+		if err != nil {
+			return nil, err
+		}
+		req.DestinationId = &destIDs[0]
+	}
+
+	resp, err := c.EntriesCopy(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("c.EntriesCopy: %w", err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("io.ReadAll: %w", err)
+	}
+
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("failed to copy entries %+v: %w", entryIDs, newAPIError(resp.StatusCode, body))
+	}
+	if err := checkEnvelope(resp.StatusCode, body); err != nil {
+		return nil, err
+	}
+
+	var copyResp entriesCopyResponse
+	if err := json.Unmarshal(body, &copyResp); err != nil {
+		return nil, fmt.Errorf("failed to parse copy response: %w\n%s", err, body)
+	}
+
+	return copyResp.Entries, nil
+}
+
+// MoveBatch applies a set of moves, mapping each entry ID to its destination
+// folder ID (or nil for the root folder). Moves sharing a destination are sent
+// in a single request. All destinations are attempted and any errors are joined.
+func (c *Client) MoveBatch(ctx context.Context, moves map[int64]*int64) error {
+	var toRoot []int64
+	byDest := map[int64][]int64{}
+	for id, dest := range moves {
+		if dest == nil {
+			toRoot = append(toRoot, id)
+			continue
+		}
+		byDest[*dest] = append(byDest[*dest], id)
+	}
+
+	var errs []error
+	if len(toRoot) > 0 {
+		slices.Sort(toRoot)
+		if err := c.MoveEntries(ctx, toRoot, nil); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	for _, dest := range slices.Sorted(maps.Keys(byDest)) {
+		ids := byDest[dest]
+		slices.Sort(ids)
+		if err := c.MoveEntries(ctx, ids, Ptr(dest)); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// ChildCount returns the number of entries in the folder parentID using the
+// total reported by the first page of a listing, so the entries themselves
+// are not fetched. The count is whatever the server reports for the
+// parentIds filter and is not checked client-side as listFolder's results are.
+// Since the API cannot filter a listing to the root folder, a nil parentID
+// lists the whole root folder and counts its entries client-side instead.
+func (c *Client) ChildCount(ctx context.Context, parentID *int64) (int, error) {
+	if parentID == nil {
+		entries, err := c.listFolder(ctx, nil)
+		if err != nil {
+			return 0, err
+		}
+		return len(entries), nil
+	}
+
+	params := &IndexEntryParams{
+		PerPage:   Ptr(int64(1)),
+		Page:      Ptr(int64(1)),
+		ParentIds: &[]string{fmt.Sprintf("%v", *parentID)},
+	}
+
+	pageResp, err := c.indexEntryPage(ctx, params)
+	if err != nil {
+		return 0, err
+	}
+	return int(pageResp.Total), nil
+}
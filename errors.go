@@ -0,0 +1,72 @@
+package folderfort
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+var (
+	// ErrFolderNotFound is returned, possibly wrapped, when a folder looked
+	// up by name does not exist.
+	ErrFolderNotFound = errors.New("folder not found")
+
+	// ErrEntryNotFound is returned, possibly wrapped, when an entry looked up
+	// by ID does not exist. The error also wraps the API's 404 *APIError.
+	ErrEntryNotFound = errors.New("entry not found")
+
+	// ErrFolderExists is returned, possibly wrapped, by CreateFolderStrict
+	// when the folder to create is already present.
+	ErrFolderExists = errors.New("folder already exists")
+)
+
+// APIError is returned when FolderFort reports that a request failed.
+// It is usually wrapped with context about the operation, so use errors.As
+// to inspect it:
+//
+//	var apiErr *folderfort.APIError
+//	if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound {
+//		// ...
+//	}
+type APIError struct {
+	// StatusCode is the HTTP status of the response. It may be 200 if the
+	// failure was only reported in the body of the response.
+	StatusCode int
+	// Message is the error message reported by the API, if any.
+	Message string
+	// Raw is the unparsed response body.
+	Raw []byte
+}
+
+func (e *APIError) Error() string {
+	msg := e.Message
+	if msg == "" {
+		msg = string(e.Raw)
+	}
+	return fmt.Sprintf("folderfort: %v %v: %v", e.StatusCode, http.StatusText(e.StatusCode), msg)
+}
+
+// newAPIError returns an *APIError for a failed response with the given
+// status and body, extracting the API's error message if there is one.
+func newAPIError(statusCode int, body []byte) *APIError {
+	var env struct {
+		Message string `json:"message"`
+	}
+	_ = json.Unmarshal(body, &env)
+	return &APIError{StatusCode: statusCode, Message: env.Message, Raw: body}
+}
+
+// checkEnvelope returns an *APIError if body is a JSON envelope whose
+// "status" is "error", which the API may send even with an HTTP 200 response.
+func checkEnvelope(statusCode int, body []byte) error {
+	var env struct {
+		Status  string `json:"status"`
+		Message string `json:"message"`
+	}
+	if err := json.Unmarshal(body, &env); err != nil || !strings.EqualFold(env.Status, "error") {
+		return nil
+	}
+	return &APIError{StatusCode: statusCode, Message: env.Message, Raw: body}
+}
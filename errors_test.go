@@ -0,0 +1,327 @@
+package folderfort
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestCheckEnvelope(t *testing.T) {
+	tests := []struct {
+		name    string
+		body    string
+		wantErr bool
+	}{
+		{name: "success", body: `{"status":"success"}`},
+		{name: "no status", body: `{"folder":{"id":1}}`},
+		{name: "not JSON", body: `hello`},
+		{name: "error", body: `{"status":"error","message":"nope"}`, wantErr: true},
+		{name: "error uppercase", body: `{"status":"ERROR"}`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkEnvelope(200, []byte(tt.body))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("checkEnvelope = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestErrorEnvelope(t *testing.T) {
+	const envelope = `{"status":"error","message":"storage quota exceeded"}`
+
+	tests := []struct {
+		name string
+		path string
+		call func(c *Client) error
+	}{
+		{
+			name: "CreateFolder",
+			path: "/folders",
+			call: func(c *Client) error {
+				_, err := c.GetOrCreateFolder(context.Background(), "docs", nil)
+				return err
+			},
+		},
+		{
+			name: "Upload",
+			path: "/uploads",
+			call: func(c *Client) error {
+				return c.UploadFile(context.Background(), "x.txt", strings.NewReader("hello"), "text/plain", nil, false)
+			},
+		},
+		{
+			name: "List",
+			path: "/drive/file-entries",
+			call: func(c *Client) error {
+				_, err := c.SearchEntries(context.Background(), SearchParams{})
+				return err
+			},
+		},
+		{
+			name: "Move",
+			path: "/file-entries/move",
+			call: func(c *Client) error {
+				return c.MoveEntries(context.Background(), []int64{1}, nil)
+			},
+		},
+		{
+			name: "Delete",
+			path: "/file-entries",
+			call: func(c *Client) error {
+				return c.DeleteEntriesByID(context.Background(), []int64{1})
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, _ := newFakeClient(t, func(req recordedRequest) (int, string) {
+				switch req.Path {
+				case tt.path:
+					return 200, envelope
+				case "/drive/file-entries":
+					return 200, indexPage(t, 1, 1)
+				}
+				return 500, `{"message":"unexpected request"}`
+			}, WithMaxFileSize(-1))
+
+			err := tt.call(c)
+			var apiErr *APIError
+			if !errors.As(err, &apiErr) {
+				t.Fatalf("err = %v, want an *APIError", err)
+			}
+			if apiErr.StatusCode != 200 || apiErr.Message != "storage quota exceeded" {
+				t.Errorf("err = %+v, want status 200 and the envelope's message", apiErr)
+			}
+		})
+	}
+}
+
+func TestNewAPIError(t *testing.T) {
+	err := newAPIError(404, []byte(`{"message":"Not found"}`))
+	if err.Message != "Not found" || err.StatusCode != 404 {
+		t.Errorf("newAPIError = %+v", err)
+	}
+	if got := err.Error(); got != "folderfort: 404 Not Found: Not found" {
+		t.Errorf("Error() = %q", got)
+	}
+
+	raw := newAPIError(502, []byte("bad gateway"))
+	if got := raw.Error(); got != "folderfort: 502 Bad Gateway: bad gateway" {
+		t.Errorf("Error() = %q", got)
+	}
+}
+
+func TestGetEntry_NotFound(t *testing.T) {
+	c, _ := newFakeClient(t, func(req recordedRequest) (int, string) {
+		return 404, `{"message":"Entry not found."}`
+	})
+
+	_, err := c.GetEntry(context.Background(), 42)
+	if !errors.Is(err, ErrEntryNotFound) {
+		t.Errorf("GetEntry error = %v, want ErrEntryNotFound", err)
+	}
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != 404 {
+		t.Errorf("GetEntry error = %v, want a 404 *APIError", err)
+	}
+}
+
+func TestGetOrCreateFolder_LookupFailure(t *testing.T) {
+	c, ft := newFakeClient(t, func(req recordedRequest) (int, string) {
+		return 500, `{"message":"boom"}`
+	})
+
+	if _, err := c.GetOrCreateFolder(context.Background(), "docs", nil); err == nil {
+		t.Fatal("GetOrCreateFolder succeeded despite the failed lookup")
+	}
+	if got := ft.requestsTo("POST", "/folders"); len(got) != 0 {
+		t.Errorf("created %v folders after a failed lookup, want none", len(got))
+	}
+}
+
+func TestPing(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		wantErr error
+	}{
+		{name: "ok", status: 200},
+		{name: "unauthorized", status: 401, wantErr: ErrUnauthorized},
+		{name: "forbidden", status: 403, wantErr: ErrUnauthorized},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, _ := newFakeClient(t, func(req recordedRequest) (int, string) {
+				return tt.status, `{"used":1,"available":2}`
+			})
+			if err := c.Ping(context.Background()); !errors.Is(err, tt.wantErr) || (err == nil) != (tt.wantErr == nil) {
+				t.Errorf("Ping error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	c, err := NewClientWithAPIToken("http://127.0.0.1:1/api/v1", "token", false, WithRetry(0, 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := c.Ping(context.Background()); !errors.Is(err, ErrUnreachable) {
+		t.Errorf("Ping of a closed port error = %v, want ErrUnreachable", err)
+	}
+}
+
+func TestCreateFolderStrict(t *testing.T) {
+	c, ft := newFakeClient(t, func(req recordedRequest) (int, string) {
+		switch req.Path {
+		case "/drive/file-entries":
+			return 200, indexPage(t, 1, 1, folderEntry(7, "docs", nil))
+		case "/folders":
+			return 200, `{"status":"success","folder":{"id":8,"name":"new","parent_id":null}}`
+		}
+		return 500, `{"message":"unexpected request"}`
+	})
+
+	ctx := context.Background()
+	if _, err := c.CreateFolderStrict(ctx, "docs", nil); !errors.Is(err, ErrFolderExists) {
+		t.Errorf("CreateFolderStrict of an existing folder error = %v, want ErrFolderExists", err)
+	}
+	id, err := c.CreateFolderStrict(ctx, "new", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *id != 8 {
+		t.Errorf("CreateFolderStrict = %v, want 8", *id)
+	}
+	if _, err := c.CreateFolderStrict(ctx, "new", nil); !errors.Is(err, ErrFolderExists) {
+		t.Errorf("second CreateFolderStrict error = %v, want ErrFolderExists", err)
+	}
+	if n := len(ft.requestsTo("POST", "/folders")); n != 1 {
+		t.Errorf("got %v folder creations, want 1", n)
+	}
+}
+
+func TestStatPath(t *testing.T) {
+	c, ft := newFakeClient(t, func(req recordedRequest) (int, string) {
+		if req.Path != "/drive/file-entries" {
+			return 500, `{"message":"unexpected request"}`
+		}
+		switch req.Query.Get("query") {
+		case "docs":
+			return 200, indexPage(t, 1, 1, folderEntry(7, "docs", nil))
+		case "a.txt":
+			return 200, indexPage(t, 1, 1, map[string]any{"id": 9, "name": "a.txt", "type": "text", "parent_id": 7, "file_size": 42})
+		}
+		return 200, indexPage(t, 1, 1)
+	})
+
+	ctx := context.Background()
+	e, err := c.StatPath(ctx, "docs/a.txt", nil)
+	if err != nil {
+		t.Fatalf("StatPath: %v", err)
+	}
+	if e.ID != 9 || e.Size != 42 {
+		t.Errorf("StatPath = %+v, want ID 9 and size 42", e)
+	}
+	for _, p := range []string{"docs/missing.txt", "missing/a.txt"} {
+		if _, err := c.StatPath(ctx, p, nil); !errors.Is(err, ErrEntryNotFound) {
+			t.Errorf("StatPath(%q) error = %v, want ErrEntryNotFound", p, err)
+		}
+	}
+	for _, p := range ft.paths() {
+		if p != "GET /drive/file-entries" {
+			t.Errorf("unexpected request %v; StatPath must not create anything", p)
+		}
+	}
+}
+
+func TestServerMaxUploadSize_Cached(t *testing.T) {
+	tests := []struct {
+		name    string
+		body    string
+		wantErr bool
+	}{
+		{name: "limit", body: `{"status":"success","max_size":10}`},
+		{name: "error envelope", body: `{"status":"error","message":"nope"}`, wantErr: true},
+		{name: "bad json", body: `{"max_size":"ten"}`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, ft := newFakeClient(t, func(req recordedRequest) (int, string) {
+				return 200, tt.body
+			})
+
+			var wg sync.WaitGroup
+			for range 5 {
+				wg.Go(func() { c.ServerMaxUploadSize(context.Background()) })
+			}
+			wg.Wait()
+			c.ServerMaxUploadSize(context.Background())
+			if n := len(ft.requestsTo("GET", "/uploads/config")); n != 1 {
+				t.Errorf("got %v requests to /uploads/config, want 1", n)
+			}
+
+			// Errors are asked again once they expire; limits never are.
+			l := &c.doer().serverMaxSize
+			l.mu.Lock()
+			l.expires = time.Now().Add(-time.Second)
+			l.mu.Unlock()
+			c.ServerMaxUploadSize(context.Background())
+			want := 1
+			if tt.wantErr {
+				want = 2
+			}
+			if n := len(ft.requestsTo("GET", "/uploads/config")); n != want {
+				t.Errorf("after expiry got %v requests to /uploads/config, want %v", n, want)
+			}
+		})
+	}
+}
+
+func TestUploadFile_OversizeOverwriteKeepsExisting(t *testing.T) {
+	for _, sized := range []bool{false, true} {
+		c, ft := newFakeClient(t, func(req recordedRequest) (int, string) {
+			if req.Path == "/drive/file-entries" {
+				return 200, indexPage(t, 1, 1, map[string]any{"id": 55, "name": "x.txt", "type": "text", "parent_id": 9})
+			}
+			return 500, `{"message":"unexpected request"}`
+		}, WithMaxFileSize(3))
+
+		var err error
+		if sized {
+			_, err = c.UploadReaderSized(context.Background(), "x.txt", strings.NewReader("hello"), 5, "text/plain", Ptr(int64(9)), true)
+		} else {
+			err = c.UploadFile(context.Background(), "x.txt", strings.NewReader("hello"), "text/plain", Ptr(int64(9)), true)
+		}
+		if !errors.Is(err, ErrFileTooLarge) {
+			t.Errorf("sized=%v: err = %v, want ErrFileTooLarge", sized, err)
+		}
+		if deletes := ft.requestsTo("POST", "/file-entries"); len(deletes) != 0 {
+			t.Errorf("sized=%v: deleted the existing file before rejecting the upload", sized)
+		}
+	}
+}
+
+func TestServerMaxUploadSize_CachedWithPlainClient(t *testing.T) {
+	ft := &fakeTransport{handler: func(req recordedRequest) (int, string) {
+		return 200, `{"status":"success","max_size":10}`
+	}}
+	c, err := NewClient("https://example.com/api/v1", WithHTTPClient(ft))
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+
+	for range 2 {
+		if size, err := c.ServerMaxUploadSize(context.Background()); err != nil || size != 10 {
+			t.Fatalf("ServerMaxUploadSize = %v, %v, want 10", size, err)
+		}
+	}
+	if n := len(ft.requestsTo("GET", "/uploads/config")); n != 1 {
+		t.Errorf("got %v requests to /uploads/config, want 1", n)
+	}
+}
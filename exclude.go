@@ -0,0 +1,87 @@
+package folderfort
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	ignore "github.com/sabhiram/go-gitignore"
+)
+
+// excluder applies the exclusion rules of an UploadOptions to one directory tree.
+type excluder struct {
+	opts      *UploadOptions
+	gitignore *ignore.GitIgnore // nil if there are no gitignore patterns
+}
+
+// newExcluder compiles the gitignore-style rules of opts for the tree rooted
+// at dir within fsys, or on the local disk if fsys is nil.
+func (o *UploadOptions) newExcluder(fsys fs.FS, dir string) (*excluder, error) {
+	lines := o.GitignorePatterns
+	if o.UseGitignoreFile {
+		var buf []byte
+		var err error
+		if fsys == nil {
+			buf, err = os.ReadFile(filepath.Join(dir, ".gitignore"))
+		} else {
+			buf, err = fs.ReadFile(fsys, path.Join(dir, ".gitignore"))
+		}
+		switch {
+		case err == nil:
+			lines = append(strings.Split(string(buf), "\n"), lines...)
+		case !errors.Is(err, fs.ErrNotExist):
+			return nil, fmt.Errorf("error reading .gitignore: %w", err)
+		}
+	}
+
+	ex := &excluder{opts: o}
+	if len(lines) > 0 {
+		ex.gitignore = ignore.CompileIgnoreLines(lines...)
+	}
+	return ex, nil
+}
+
+// excluded reports whether the entry with the given base name at path,
+// whose path relative to the top-level directory is relPath, should be skipped.
+func (ex *excluder) excluded(name, path, relPath string, isDir bool) bool {
+	if ex.opts.excluded(name, path) {
+		return true
+	}
+	if ex.gitignore == nil {
+		return false
+	}
+	if isDir {
+		relPath += "/"
+	}
+	return ex.gitignore.MatchesPath(relPath)
+}
+
+// errSymlinkLoop is reported when a followed symlink leads back to a directory being walked.
+var errSymlinkLoop = errors.New("symlink loop")
+
+// followSymlink returns the FileInfo of the target of the symlink at path.
+// It returns an error if follow is false, the link is broken, or the target
+// is one of ancestors, the real paths of the directories currently being walked.
+func followSymlink(path string, follow bool, ancestors map[string]bool) (fs.FileInfo, error) {
+	if !follow {
+		return nil, errors.New("not following symlinks")
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if info.IsDir() {
+		realPath, err := filepath.EvalSymlinks(path)
+		if err != nil {
+			return nil, err
+		}
+		if ancestors[realPath] {
+			return nil, errSymlinkLoop
+		}
+	}
+	return info, nil
+}
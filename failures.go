@@ -0,0 +1,85 @@
+package folderfort
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+)
+
+// FailedUpload records a file that failed to upload. A list of them, as
+// written to UploadOptions.FailureFile, can be passed to UploadFiles to retry.
+type FailedUpload struct {
+	LocalPath string `json:"local_path"`
+	// ParentID is the destination folder, or nil for the root folder.
+	ParentID *int64 `json:"parent_id"`
+	// Err is the error from the failed attempt, if known. It is ignored by UploadFiles.
+	Err string `json:"error,omitempty"`
+}
+
+// writeFailureFile writes failed to the JSON file filename.
+// It does nothing if filename is empty or there are no failures.
+func writeFailureFile(filename string, failed []FailedUpload) error {
+	if filename == "" || len(failed) == 0 {
+		return nil
+	}
+	buf, err := json.MarshalIndent(failed, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal failures: %w", err)
+	}
+	if err := os.WriteFile(filename, buf, 0644); err != nil {
+		return fmt.Errorf("failed to write failure file: %w", err)
+	}
+	return nil
+}
+
+// ReadFailureFile reads a list of failed uploads written by
+// UploadDirectoryWithOptions when UploadOptions.FailureFile is set.
+func ReadFailureFile(filename string) ([]FailedUpload, error) {
+	buf, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	var failed []FailedUpload
+	if err := json.Unmarshal(buf, &failed); err != nil {
+		return nil, fmt.Errorf("failed to parse failure file %v: %w", filename, err)
+	}
+	return failed, nil
+}
+
+// UploadFiles uploads each local file into its ParentID folder, overwriting any
+// file of the same name. It is typically used to retry the failures recorded by
+// UploadDirectoryWithOptions. Of opts, only ContinueOnError, FailureFile and
+// MIMETypeFunc are used; a nil opts uses the defaults.
+// The returned Stats.IDs are keyed by each file's LocalPath.
+func (c *Client) UploadFiles(ctx context.Context, files []FailedUpload, opts *UploadOptions) (*Stats, error) {
+	if opts == nil {
+		opts = &UploadOptions{}
+	}
+
+	stats := &Stats{IDs: map[string]int64{}}
+	var errs []error
+	for _, f := range files {
+		if err := ctx.Err(); err != nil {
+			return stats, err
+		}
+
+		result, err := c.uploadFileFromPath(ctx, nil, f.LocalPath, "", opts.mimeType(f.LocalPath), f.ParentID, true, nil)
+		if err != nil && opts.ContinueOnError {
+			stats.Failed = append(stats.Failed, FailedUpload{LocalPath: f.LocalPath, ParentID: f.ParentID, Err: err.Error()})
+			errs = append(errs, err)
+			continue
+		}
+		if err != nil {
+			return stats, err
+		}
+		stats.IDs[f.LocalPath] = result.ID
+	}
+
+	err := errors.Join(errs...)
+	if ferr := writeFailureFile(opts.FailureFile, stats.Failed); ferr != nil {
+		err = errors.Join(err, ferr)
+	}
+	return stats, err
+}
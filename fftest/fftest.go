@@ -0,0 +1,520 @@
+// Package fftest provides an in-memory FolderFort API server for testing
+// code that uses the folderfort package without reaching the real service.
+//
+// The server implements enough of the API for folder creation, uploads,
+// listing (IndexEntry), fetching and downloading entries, and deletion:
+//
+//	srv := fftest.NewTestServer()
+//	defer srv.Close()
+//	client, err := folderfort.NewClientWithAPIToken(srv.APIURL(), "token", false)
+//
+// Faults can be injected to exercise retry and error handling:
+//
+//	srv.InjectFault(fftest.Fault{Call: 3, StatusCode: http.StatusTooManyRequests})
+package fftest
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// APIPath is the path below the server's URL at which the API is served.
+const APIPath = "/api/v1"
+
+// Entry is a file or folder stored by a Server.
+type Entry struct {
+	ID       int64
+	Name     string
+	ParentID *int64 // nil for the root folder
+	Type     string // "folder", or a file type such as "image" or "text"
+	MIME     string
+	Content  []byte
+	Starred  bool
+	Deleted  bool // in the trash
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// Fault describes an error response injected by InjectFault.
+type Fault struct {
+	// Call is the 1-based number of the request that fails, counting every
+	// request received by the server, including failed ones.
+	Call int
+	// StatusCode is the HTTP status of the response.
+	StatusCode int
+	// Body is the response body. If empty, a JSON error envelope is sent.
+	Body string
+	// Header holds extra response headers, such as Retry-After.
+	Header http.Header
+}
+
+// Request records a request received by a Server.
+type Request struct {
+	Method string
+	// Path is the request path below APIPath, such as "/folders".
+	Path  string
+	Query string
+}
+
+// Server is an in-memory FolderFort API server. Its methods are safe for
+// concurrent use.
+type Server struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	entries  map[int64]*Entry
+	nextID   int64
+	faults   []Fault
+	requests []Request
+}
+
+// NewTestServer starts and returns a new Server holding no entries.
+// Any non-empty bearer token is accepted. The caller should call Close when finished.
+func NewTestServer() *Server {
+	s := &Server{entries: map[int64]*Entry{}, nextID: 1}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST "+APIPath+"/uploads", s.handleUpload)
+	mux.HandleFunc("POST "+APIPath+"/folders", s.handleCreateFolder)
+	mux.HandleFunc("GET "+APIPath+"/drive/file-entries", s.handleIndex)
+	mux.HandleFunc("POST "+APIPath+"/file-entries", s.handleDelete)
+	mux.HandleFunc("DELETE "+APIPath+"/file-entries", s.handleDelete)
+	mux.HandleFunc("GET "+APIPath+"/file-entries/{id}", s.handleShow)
+	mux.HandleFunc("GET "+APIPath+"/file-entries/{id}/download", s.handleDownload)
+
+	s.Server = httptest.NewServer(s.middleware(mux))
+	return s
+}
+
+// APIURL returns the URL to pass to folderfort.NewClientWithAPIToken.
+func (s *Server) APIURL() string {
+	return s.URL + APIPath
+}
+
+// InjectFault makes the server answer request number f.Call with an error.
+func (s *Server) InjectFault(f Fault) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults = append(s.faults, f)
+}
+
+// Requests returns every request received so far, in order.
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return slices.Clone(s.requests)
+}
+
+// Entries returns a copy of every entry, including those in the trash, ordered by ID.
+func (s *Server) Entries() []Entry {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	entries := make([]Entry, 0, len(s.entries))
+	for _, e := range s.sortedEntries() {
+		entries = append(entries, *e)
+	}
+	return entries
+}
+
+// AddFolder creates a folder named name in parentID (or the root folder if
+// nil) and returns its ID. It is meant for seeding test data.
+func (s *Server) AddFolder(name string, parentID *int64) int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.add(&Entry{Name: name, ParentID: parentID, Type: "folder"}).ID
+}
+
+// AddFile creates a file named name with the given content in parentID (or
+// the root folder if nil) and returns its ID. It is meant for seeding test data.
+func (s *Server) AddFile(name string, parentID *int64, content []byte) int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	mimeType := mime.TypeByExtension(path.Ext(name))
+	return s.add(&Entry{Name: name, ParentID: parentID, Type: fileType(mimeType), MIME: mimeType, Content: content}).ID
+}
+
+// add stores e under a new ID. s.mu must be held.
+func (s *Server) add(e *Entry) *Entry {
+	e.ID = s.nextID
+	s.nextID++
+	now := time.Now().UTC()
+	e.CreatedAt, e.UpdatedAt = now, now
+	s.entries[e.ID] = e
+	return e
+}
+
+// sortedEntries returns the stored entries ordered by ID. s.mu must be held.
+func (s *Server) sortedEntries() []*Entry {
+	entries := make([]*Entry, 0, len(s.entries))
+	for _, e := range s.entries {
+		entries = append(entries, e)
+	}
+	slices.SortFunc(entries, func(a, b *Entry) int { return int(a.ID - b.ID) })
+	return entries
+}
+
+// middleware records each request, injects faults and checks authorization.
+func (s *Server) middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		s.requests = append(s.requests, Request{
+			Method: r.Method,
+			Path:   strings.TrimPrefix(r.URL.Path, APIPath),
+			Query:  r.URL.RawQuery,
+		})
+		call := len(s.requests)
+		var fault *Fault
+		for i, f := range s.faults {
+			if f.Call == call {
+				fault = &s.faults[i]
+				break
+			}
+		}
+		s.mu.Unlock()
+
+		if fault != nil {
+			for k, v := range fault.Header {
+				w.Header()[k] = v
+			}
+			if fault.Body == "" {
+				writeError(w, fault.StatusCode, "injected fault")
+				return
+			}
+			w.WriteHeader(fault.StatusCode)
+			io.WriteString(w, fault.Body)
+			return
+		}
+
+		if token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer "); !ok || token == "" {
+			writeError(w, http.StatusUnauthorized, "Unauthenticated.")
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, map[string]string{"status": "error", "message": message})
+}
+
+// entryJSON is the API representation of an Entry.
+type entryJSON struct {
+	ID        int64   `json:"id"`
+	Name      string  `json:"name"`
+	FileName  string  `json:"file_name"`
+	ParentID  *int64  `json:"parent_id"`
+	Path      string  `json:"path"`
+	Type      string  `json:"type"`
+	Size      int64   `json:"file_size"`
+	MIME      string  `json:"mime,omitempty"`
+	URL       string  `json:"url"`
+	CreatedAt string  `json:"created_at"`
+	UpdatedAt string  `json:"updated_at"`
+	DeletedAt *string `json:"deleted_at"`
+}
+
+// toJSON returns the API representation of e. s.mu must be held.
+func (s *Server) toJSON(e *Entry) entryJSON {
+	var ids []string
+	for p := e.ParentID; p != nil; {
+		ids = append([]string{strconv.FormatInt(*p, 10)}, ids...)
+		parent := s.entries[*p]
+		if parent == nil {
+			break
+		}
+		p = parent.ParentID
+	}
+	ids = append(ids, strconv.FormatInt(e.ID, 10))
+
+	j := entryJSON{
+		ID:        e.ID,
+		Name:      e.Name,
+		FileName:  fmt.Sprintf("fftest-%v", e.ID),
+		ParentID:  e.ParentID,
+		Path:      strings.Join(ids, "/"),
+		Type:      e.Type,
+		Size:      int64(len(e.Content)),
+		MIME:      e.MIME,
+		URL:       fmt.Sprintf("api/v1/file-entries/%v", e.ID),
+		CreatedAt: e.CreatedAt.Format(time.RFC3339Nano),
+		UpdatedAt: e.UpdatedAt.Format(time.RFC3339Nano),
+	}
+	if e.Deleted {
+		j.DeletedAt = &j.UpdatedAt
+	}
+	return j
+}
+
+// fileType returns the entry type the API reports for a file of mimeType.
+func fileType(mimeType string) string {
+	switch major, _, _ := strings.Cut(mimeType, "/"); {
+	case mimeType == "application/pdf":
+		return "pdf"
+	case major == "image", major == "text", major == "audio", major == "video":
+		return major
+	default:
+		return "file"
+	}
+}
+
+func parseOptionalID(s string) (*int64, error) {
+	if s == "" || s == "null" {
+		return nil, nil
+	}
+	id, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	return &id, nil
+}
+
+// checkParent returns an error message if parentID is not nil and is not a
+// live folder. s.mu must be held.
+func (s *Server) checkParent(parentID *int64) string {
+	if parentID == nil {
+		return ""
+	}
+	if p := s.entries[*parentID]; p == nil || p.Deleted || p.Type != "folder" {
+		return fmt.Sprintf("parent folder %v not found", *parentID)
+	}
+	return ""
+}
+
+func (s *Server) handleUpload(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseMultipartForm(32 << 20); err != nil {
+		writeError(w, http.StatusUnprocessableEntity, err.Error())
+		return
+	}
+	file, header, err := r.FormFile("file")
+	if err != nil {
+		writeError(w, http.StatusUnprocessableEntity, "The file field is required.")
+		return
+	}
+	defer file.Close()
+	content, err := io.ReadAll(file)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	parentID, err := parseOptionalID(r.FormValue("parentId"))
+	if err != nil {
+		writeError(w, http.StatusUnprocessableEntity, "The parent id must be an integer.")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if msg := s.checkParent(parentID); msg != "" {
+		writeError(w, http.StatusUnprocessableEntity, msg)
+		return
+	}
+	mimeType := header.Header.Get("Content-Type")
+	e := s.add(&Entry{Name: header.Filename, ParentID: parentID, Type: fileType(mimeType), MIME: mimeType, Content: content})
+	writeJSON(w, http.StatusCreated, map[string]any{"status": "success", "fileEntry": s.toJSON(e)})
+}
+
+func (s *Server) handleCreateFolder(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		Name     string `json:"name"`
+		ParentID *int64 `json:"parentId"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.Name == "" {
+		writeError(w, http.StatusUnprocessableEntity, "The name field is required.")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if msg := s.checkParent(req.ParentID); msg != "" {
+		writeError(w, http.StatusUnprocessableEntity, msg)
+		return
+	}
+	for _, e := range s.entries {
+		if !e.Deleted && e.Type == "folder" && sameParent(e.ParentID, req.ParentID) && strings.EqualFold(e.Name, req.Name) {
+			writeError(w, http.StatusUnprocessableEntity, "Folder with same name already exists.")
+			return
+		}
+	}
+	e := s.add(&Entry{Name: req.Name, ParentID: req.ParentID, Type: "folder"})
+	writeJSON(w, http.StatusOK, map[string]any{"status": "success", "folder": s.toJSON(e)})
+}
+
+// handleIndex lists entries. Without parentIds only entries in the root
+// folder are listed, unless a query is given, in which case the whole drive
+// is searched.
+func (s *Server) handleIndex(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	perPage, page := int64(50), int64(1)
+	if v := q.Get("perPage"); v != "" {
+		n, err := strconv.ParseInt(v, 10, 64)
+		if err != nil || n <= 0 {
+			writeError(w, http.StatusUnprocessableEntity, "The per page must be a positive integer.")
+			return
+		}
+		perPage = n
+	}
+	if v := q.Get("page"); v != "" {
+		n, err := strconv.ParseInt(v, 10, 64)
+		if err != nil || n <= 0 {
+			writeError(w, http.StatusUnprocessableEntity, "The page must be a positive integer.")
+			return
+		}
+		page = n
+	}
+	var parentIDs []int64
+	for _, v := range q["parentIds"] {
+		for _, id := range strings.Split(v, ",") {
+			n, err := strconv.ParseInt(id, 10, 64)
+			if err != nil {
+				writeError(w, http.StatusUnprocessableEntity, "The parent ids must be integers.")
+				return
+			}
+			parentIDs = append(parentIDs, n)
+		}
+	}
+	query := strings.ToLower(q.Get("query"))
+	typ := q.Get("type")
+	deletedOnly := q.Get("deletedOnly") == "true"
+	starredOnly := q.Get("starredOnly") == "true"
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var matches []entryJSON
+	for _, e := range s.sortedEntries() {
+		switch {
+		case e.Deleted != deletedOnly,
+			starredOnly && !e.Starred,
+			typ != "" && e.Type != typ,
+			query != "" && !strings.Contains(strings.ToLower(e.Name), query),
+			len(parentIDs) > 0 && (e.ParentID == nil || !slices.Contains(parentIDs, *e.ParentID)),
+			len(parentIDs) == 0 && query == "" && !deletedOnly && e.ParentID != nil:
+			continue
+		}
+		matches = append(matches, s.toJSON(e))
+	}
+
+	total := int64(len(matches))
+	lastPage := max((total+perPage-1)/perPage, 1)
+	start := min((page-1)*perPage, total)
+	end := min(start+perPage, total)
+	writeJSON(w, http.StatusOK, map[string]any{
+		"current_page": page,
+		"last_page":    lastPage,
+		"per_page":     perPage,
+		"total":        total,
+		"data":         append([]entryJSON{}, matches[start:end]...),
+	})
+}
+
+func (s *Server) handleDelete(w http.ResponseWriter, r *http.Request) {
+	if r.Method == http.MethodPost && r.Header.Get("X-HTTP-Method-Override") != http.MethodDelete {
+		writeError(w, http.StatusMethodNotAllowed, "The POST method is not supported for this route.")
+		return
+	}
+	var req struct {
+		EntryIDs      []string `json:"entryIds"`
+		DeleteForever any      `json:"deleteForever"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil || len(req.EntryIDs) == 0 {
+		writeError(w, http.StatusUnprocessableEntity, "The entry ids field is required.")
+		return
+	}
+	forever := fmt.Sprint(req.DeleteForever) == "true"
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var ids []int64
+	for _, v := range req.EntryIDs {
+		id, err := strconv.ParseInt(v, 10, 64)
+		if err != nil || s.entries[id] == nil {
+			writeError(w, http.StatusUnprocessableEntity, fmt.Sprintf("The selected entry id %v is invalid.", v))
+			return
+		}
+		ids = append(ids, id)
+	}
+	for _, id := range ids {
+		s.remove(id, forever)
+	}
+	writeJSON(w, http.StatusOK, map[string]string{"status": "success"})
+}
+
+// remove moves the entry id and its descendants to the trash, or deletes
+// them if forever is true. s.mu must be held.
+func (s *Server) remove(id int64, forever bool) {
+	for _, e := range s.sortedEntries() {
+		if e.ParentID != nil && *e.ParentID == id {
+			s.remove(e.ID, forever)
+		}
+	}
+	if forever {
+		delete(s.entries, id)
+		return
+	}
+	if e := s.entries[id]; e != nil {
+		e.Deleted = true
+		e.UpdatedAt = time.Now().UTC()
+	}
+}
+
+// lookup returns the live entry named by the request's {id}, writing an
+// error response and returning nil if there is none. s.mu must be held.
+func (s *Server) lookup(w http.ResponseWriter, r *http.Request) *Entry {
+	id, err := strconv.ParseInt(r.PathValue("id"), 10, 64)
+	if err != nil || s.entries[id] == nil || s.entries[id].Deleted {
+		writeError(w, http.StatusNotFound, "Entry not found.")
+		return nil
+	}
+	return s.entries[id]
+}
+
+func (s *Server) handleShow(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if e := s.lookup(w, r); e != nil {
+		writeJSON(w, http.StatusOK, map[string]any{"status": "success", "fileEntry": s.toJSON(e)})
+	}
+}
+
+func (s *Server) handleDownload(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	e := s.lookup(w, r)
+	if e == nil {
+		s.mu.Unlock()
+		return
+	}
+	isFolder, content, mimeType := e.Type == "folder", e.Content, e.MIME
+	s.mu.Unlock()
+
+	if isFolder {
+		writeError(w, http.StatusUnprocessableEntity, "Folders cannot be downloaded.")
+		return
+	}
+	if mimeType != "" {
+		w.Header().Set("Content-Type", mimeType)
+	}
+	w.Write(content)
+}
+
+func sameParent(a, b *int64) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
@@ -0,0 +1,110 @@
+package fftest_test
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/gmlewis/go-folderfort"
+	"github.com/gmlewis/go-folderfort/fftest"
+)
+
+func newClient(t *testing.T, srv *fftest.Server, opts ...folderfort.ClientOption) *folderfort.Client {
+	t.Helper()
+	opts = append([]folderfort.ClientOption{folderfort.WithMaxFileSize(-1), folderfort.WithRetry(1, 0)}, opts...)
+	c, err := folderfort.NewClientWithAPIToken(srv.APIURL(), "token", false, opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func TestServer(t *testing.T) {
+	srv := fftest.NewTestServer()
+	defer srv.Close()
+	c := newClient(t, srv)
+	ctx := context.Background()
+
+	folderID, err := c.GetOrCreateFolder(ctx, "docs/notes", nil)
+	if err != nil {
+		t.Fatalf("GetOrCreateFolder: %v", err)
+	}
+	again, err := c.GetOrCreateFolderEntry(ctx, "docs/notes", nil)
+	if err != nil || again.ID != *folderID {
+		t.Fatalf("GetOrCreateFolderEntry = %+v, %v; want the existing folder %v", again, err, *folderID)
+	}
+
+	for _, content := range []string{"first", "second"} {
+		if err := c.UploadFile(ctx, "a.txt", strings.NewReader(content), "text/plain", folderID, true); err != nil {
+			t.Fatalf("UploadFile: %v", err)
+		}
+	}
+	entries, err := c.ListEntries(ctx, folderID)
+	if err != nil {
+		t.Fatalf("ListEntries: %v", err)
+	}
+	if len(entries) != 1 || entries[0].Name != "a.txt" || !entries[0].IsFile() {
+		t.Fatalf("ListEntries = %+v, want only the overwritten a.txt", entries)
+	}
+
+	var buf bytes.Buffer
+	if _, err := c.Download(ctx, entries[0].ID, &buf); err != nil {
+		t.Fatalf("Download: %v", err)
+	}
+	if buf.String() != "second" {
+		t.Errorf("downloaded %q, want %q", buf.String(), "second")
+	}
+
+	if err := c.DeleteEntriesByID(ctx, []int64{*folderID}); err != nil {
+		t.Fatalf("DeleteEntriesByID: %v", err)
+	}
+	if _, err := c.GetEntry(ctx, entries[0].ID); err == nil {
+		t.Error("GetEntry of a file in a deleted folder succeeded, want an error")
+	}
+	for _, e := range srv.Entries() {
+		if (e.ID == *folderID || e.ID == entries[0].ID) && !e.Deleted {
+			t.Errorf("entry %v (%v) is not in the trash", e.ID, e.Name)
+		}
+	}
+}
+
+func TestServer_InjectFault(t *testing.T) {
+	srv := fftest.NewTestServer()
+	defer srv.Close()
+	srv.InjectFault(fftest.Fault{Call: 1, StatusCode: http.StatusServiceUnavailable})
+	c := newClient(t, srv)
+
+	if _, err := c.GetOrCreateFolder(context.Background(), "docs", nil); err != nil {
+		t.Fatalf("GetOrCreateFolder: %v (the 503 should have been retried)", err)
+	}
+	if n := len(srv.Requests()); n != 3 {
+		t.Errorf("got %v requests, want 3: a failed listing, its retry and the folder creation", n)
+	}
+}
+
+func TestServer_FindOrphans(t *testing.T) {
+	srv := fftest.NewTestServer()
+	defer srv.Close()
+	c := newClient(t, srv)
+	ctx := context.Background()
+
+	keep := srv.AddFolder("keep", nil)
+	srv.AddFile("a.txt", &keep, []byte("a"))
+	gone := srv.AddFolder("gone", nil)
+	srv.AddFile("b.txt", &gone, []byte("b"))
+	if err := c.DeleteEntriesByID(ctx, []int64{gone}); err != nil {
+		t.Fatalf("DeleteEntriesByID: %v", err)
+	}
+	// An upload that finished after its folder was trashed.
+	late := srv.AddFile("late.txt", &gone, []byte("late"))
+
+	orphans, err := c.FindOrphans(ctx)
+	if err != nil {
+		t.Fatalf("FindOrphans: %v", err)
+	}
+	if len(orphans) != 1 || orphans[0].ID != late {
+		t.Errorf("FindOrphans = %+v, want only late.txt (%v)", orphans, late)
+	}
+}
@@ -0,0 +1,120 @@
+package folderfort
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// folderKey identifies a folder by its name within a parent folder.
+type folderKey struct {
+	parentID int64 // 0 for the root folder
+	name     string
+}
+
+func newFolderKey(name string, parentID *int64) folderKey {
+	if parentID == nil {
+		return folderKey{name: name}
+	}
+	return folderKey{parentID: *parentID, name: name}
+}
+
+// cachedFolder is what folderCache remembers about a folder.
+type cachedFolder struct {
+	id   int64
+	path string // the folder's Entry.Path
+}
+
+// folderCache remembers the IDs of folders found or created by
+// GetOrCreateFolder so that deep trees do not look up every path segment
+// again for every file.
+type folderCache struct {
+	mu    sync.Mutex
+	ids   map[folderKey]cachedFolder
+	locks map[folderKey]*folderLock // held while a folder is looked up or created
+}
+
+// folderLock is a mutex shared by the callers creating the same folder.
+type folderLock struct {
+	mu   sync.Mutex
+	refs int // number of callers holding or waiting for mu
+}
+
+func (fc *folderCache) get(name string, parentID *int64) (cachedFolder, bool) {
+	fc.mu.Lock()
+	defer fc.mu.Unlock()
+	f, ok := fc.ids[newFolderKey(name, parentID)]
+	return f, ok
+}
+
+func (fc *folderCache) put(name string, parentID *int64, f cachedFolder) {
+	fc.mu.Lock()
+	defer fc.mu.Unlock()
+	if fc.ids == nil {
+		fc.ids = map[folderKey]cachedFolder{}
+	}
+	fc.ids[newFolderKey(name, parentID)] = f
+}
+
+// lock serializes the lookup and creation of the folder name in parentID, so
+// that concurrent GetOrCreateFolder calls create it only once and the others
+// reuse its ID. Names differing only in case or surrounding whitespace share
+// a lock, since the server may treat them as the same folder.
+// It returns the function that releases the lock.
+func (fc *folderCache) lock(name string, parentID *int64) (unlock func()) {
+	key := newFolderKey(strings.ToLower(strings.TrimSpace(name)), parentID)
+
+	fc.mu.Lock()
+	if fc.locks == nil {
+		fc.locks = map[folderKey]*folderLock{}
+	}
+	l := fc.locks[key]
+	if l == nil {
+		l = &folderLock{}
+		fc.locks[key] = l
+	}
+	l.refs++
+	fc.mu.Unlock()
+
+	l.mu.Lock()
+	return func() {
+		l.mu.Unlock()
+		fc.mu.Lock()
+		defer fc.mu.Unlock()
+		if l.refs--; l.refs == 0 {
+			delete(fc.locks, key)
+		}
+	}
+}
+
+// invalidate clears the cache if any of ids (as decimal strings) is a cached
+// folder or the parent of one. Since a removed folder's descendants are
+// removed with it, the whole cache is dropped rather than tracking them.
+func (fc *folderCache) invalidate(ids []string) {
+	fc.mu.Lock()
+	defer fc.mu.Unlock()
+	cached := map[int64]bool{}
+	for k, f := range fc.ids {
+		cached[f.id] = true
+		cached[k.parentID] = true
+	}
+	for _, s := range ids {
+		if id, err := strconv.ParseInt(s, 10, 64); err == nil && cached[id] {
+			clear(fc.ids)
+			return
+		}
+	}
+}
+
+func (fc *folderCache) clear() {
+	fc.mu.Lock()
+	defer fc.mu.Unlock()
+	clear(fc.ids)
+}
+
+// ClearFolderCache forgets every folder ID remembered by GetOrCreateFolder.
+// Long-running programs should call it if folders may be deleted, renamed or
+// moved by anything other than this client.
+func (c *Client) ClearFolderCache() {
+	c.doer().folders.clear()
+}
@@ -0,0 +1,36 @@
+package folderfort
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+)
+
+// UploadFS uploads the tree rooted at root within fsys (such as an embed.FS)
+// to FolderFort, without writing it to disk first. Use "." for the whole of
+// fsys. It behaves like UploadDirectory: folders are created below parentID
+// (or the root folder if nil) as needed, existing files of the same name are
+// overwritten, files larger than the upload size limit are skipped, and
+// excludePatterns (or DefaultExcludeNames, if nil) decide what is skipped,
+// matched against slash-separated paths within fsys.
+func (c *Client) UploadFS(ctx context.Context, fsys fs.FS, root string, parentID *int64, excludePatterns []string) error {
+	opts := &UploadOptions{ExcludePatterns: excludePatterns, NoDefaultExcludes: excludePatterns != nil}
+	_, err := c.UploadFSWithOptions(ctx, fsys, root, parentID, opts)
+	return err
+}
+
+// UploadFSWithOptions is like UploadFS but takes the same options as
+// UploadDirectoryWithOptions, with paths such as those passed to
+// ExcludePatterns and recorded in the returned Stats being slash-separated
+// paths within fsys. UseGitignoreFile reads root/.gitignore from fsys.
+// Symbolic links are always skipped, and FollowSymlinks and CheckQuota,
+// which need the local disk, are rejected. A nil opts uses the defaults.
+func (c *Client) UploadFSWithOptions(ctx context.Context, fsys fs.FS, root string, parentID *int64, opts *UploadOptions) (*Stats, error) {
+	if opts == nil {
+		opts = &UploadOptions{}
+	}
+	if opts.FollowSymlinks || opts.CheckQuota {
+		return &Stats{IDs: map[string]int64{}}, errors.New("FollowSymlinks and CheckQuota are not supported by UploadFSWithOptions")
+	}
+	return c.uploadTree(ctx, fsys, root, parentID, opts)
+}
@@ -2,66 +2,248 @@ package folderfort
 
 import (
 	"bytes"
+	"compress/gzip"
 	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"hash"
 	"io"
-	"log"
-	"mime"
+	"io/fs"
+	"log/slog"
+	"mime/multipart"
 	"net/http"
+	"net/textproto"
+	"net/url"
 	"os"
+	"path"
 	"path/filepath"
+	"runtime"
+	"slices"
+	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
+	"weak"
 
-	"github.com/gmlewis/go-httpdebug/httpdebug"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/oauth2"
+	"golang.org/x/sync/errgroup"
+	"golang.org/x/time/rate"
 )
 
 // NewClientWithAPIToken creates a new client that automatically adds
 // `Authorization: Bearer <API_TOKEN>` to all requests.
-func NewClientWithAPIToken(server, apiToken string, debug bool) (*Client, error) {
+// The server must look like https://na.folderfort.com/api/v1; http:// is
+// also accepted for localhost to allow testing against a local server, and
+// WithAPIPath changes the expected path. Instead of a URL, server may name
+// a Region such as RegionNA ("na"), as mapped by BaseURLForRegion.
+// Additional opts (such as WithUploadDelay) are applied after the token
+// transport is installed.
+func NewClientWithAPIToken(server, apiToken string, debug bool, opts ...ClientOption) (*Client, error) {
 	if server == "" || apiToken == "" {
 		return nil, errors.New("missing server or apiToken")
 	}
+	return newClientWithDoer(server, newDoerWithToken(apiToken, debug), opts)
+}
 
-	if !strings.HasPrefix(server, "https://") || (!strings.HasSuffix(server, "/api/v1") && !strings.HasSuffix(server, "/api/v1/")) {
-		return nil, errors.New("server must look like: https://na.folderfort.com/api/v1")
+// newClientWithDoer creates a client for server that sends its requests
+// through d, then applies opts.
+func newClientWithDoer(server string, d *doerWithToken, opts []ClientOption) (*Client, error) {
+	server, err := resolveServer(server)
+	if err != nil {
+		return nil, err
 	}
 
 	authOpt := func(c *Client) error {
-		c.Client = &doerWithToken{apiToken: apiToken, debug: debug}
+		c.Client = d
 		return nil
 	}
 
-	return NewClient(server, authOpt)
+	// Validate the server and build the shared http.Client once every
+	// option (including WithAPIPath and WithBaseURL) has been applied.
+	buildOpt := func(c *Client) error {
+		apiPath := DefaultAPIPath
+		d, ok := c.Client.(*doerWithToken)
+		if ok {
+			apiPath = d.apiPath
+		}
+		if err := validateServerURL(c.Server, apiPath); err != nil {
+			return err
+		}
+		if ok {
+			d.httpClient()
+		}
+		return nil
+	}
+
+	opts = append([]ClientOption{authOpt}, opts...)
+	return NewClient(server, append(opts, buildOpt)...)
 }
 
+// defaultUploadDelay is the pause after each file uploaded by UploadDirectory.
+const defaultUploadDelay = 500 * time.Millisecond
+
+// DefaultCopyBufferSize is the buffer size used to stream file contents
+// unless overridden with WithCopyBufferSize. It is 8x larger than the 32KB
+// used by io.Copy, which noticeably reduces syscall overhead for large files
+// on fast links while keeping per-transfer memory modest.
+const DefaultCopyBufferSize = 256 * 1024
+
 type doerWithToken struct {
-	apiToken string
-	debug    bool
+	apiToken    string
+	tokenSource oauth2.TokenSource // replaces apiToken when set by NewClientWithTokenSource
+	debug       bool
+
+	uploadDelay    time.Duration // pause after each file upload in UploadDirectory
+	folderDelay    time.Duration // pause before each folder creation call
+	copyBufferSize int           // buffer size for streaming file contents
+	concurrency    int           // number of parallel file uploads in UploadDirectory
+	maxRetries     int           // retries of transient failures; 0 disables them
+	retryDelayBase time.Duration // first backoff delay, doubled on each retry
+	limiter        *rate.Limiter // throttles every request when set by WithRateLimit
+	bandwidth      *rate.Limiter // throttles upload bodies when set by WithUploadBandwidthLimit
+	folders        folderCache   // folder IDs resolved by GetOrCreateFolder
+
+	logger            Logger            // receives diagnostic messages
+	slog              *slog.Logger      // receives structured events
+	nameMatcher       NameMatcher       // decides whether an entry name matches a lookup
+	deleteBatchSize   int               // number of entries deleted per request
+	parentMatch       ParentMatch       // decides whether an entry is in the folder of a lookup
+	autoCreateParents bool              // whether UploadFile creates missing parent folders
+	maxFileSize       int64             // upload size limit: 0 asks the server, <0 is unlimited
+	idempotencyKeys   bool              // whether uploads carry an Idempotency-Key header
+	verifyChecksum    bool              // whether uploads are hashed and checked against the server
+	progress          ProgressFunc      // reports upload progress when set
+	mimeTypes         map[string]string // extension overrides set by WithMIMETypes
+	workspaceID       *int64            // workspace targeted by WithWorkspace; nil for the personal space
+	apiPath           string            // path the server URL must end with
+	atomicReplace     bool              // whether overwritten files are deleted only after the upload succeeds
+	compressUploads   bool              // whether compressible uploads are gzipped
+	preserveModTime   bool              // whether uploads of local files send their modification times
+
+	tracer             trace.Tracer                 // starts a span per request when set by WithTracerProvider
+	requestEditors     []func(*http.Request) error  // run on every request by Do
+	responseInspectors []func(*http.Response) error // run on every response by Do
+
+	tlsConfig     *tls.Config       // TLS settings for the default transport
+	clientCerts   []tls.Certificate // added to tlsConfig
+	proxyURL      *url.URL          // overrides the proxy environment variables
+	baseTransport http.RoundTripper // replaces the default transport entirely
+	baseClient    *http.Client      // replaces the default http.Client entirely
+	timeout       time.Duration     // http.Client.Timeout; 0 for none
+	dialTimeout   time.Duration     // connection timeout; 0 keeps the default
+	transportOnce sync.Once
+	rt            http.RoundTripper // built once from the above by transport()
+	client        *http.Client      // built along with rt and shared by all requests
+	ownTransport  *http.Transport   // the transport built by transport(), if not supplied
+	closed        atomic.Bool       // set by Close
+
+	serverMaxSize serverLimit // cached result of ServerMaxUploadSize
+}
+
+func newDoerWithToken(apiToken string, debug bool) *doerWithToken {
+	return &doerWithToken{
+		apiToken:       apiToken,
+		debug:          debug,
+		uploadDelay:    defaultUploadDelay,
+		copyBufferSize: DefaultCopyBufferSize,
+		concurrency:    1,
+		maxRetries:     defaultMaxRetries,
+		retryDelayBase: defaultRetryDelay,
+
+		logger:            nopLogger{},
+		slog:              slog.New(slog.DiscardHandler),
+		nameMatcher:       CaseInsensitiveNameMatch,
+		deleteBatchSize:   defaultDeleteBatchSize,
+		autoCreateParents: true,
+		apiPath:           DefaultAPIPath,
+	}
+}
+
+// fallbackDoers holds the doerWithToken of each Client created without
+// NewClientWithAPIToken, so that its caches and folder locks live as long as
+// the Client does. Entries are removed once their Client is collected.
+var fallbackDoers struct {
+	mu sync.Mutex
+	m  map[weak.Pointer[Client]]*doerWithToken
+}
+
+// doer returns the doerWithToken installed by NewClientWithAPIToken.
+// Clients created another way get a doerWithToken holding the defaults,
+// created on first use and reused for the life of the Client.
+func (c *Client) doer() *doerWithToken {
+	if d, ok := c.Client.(*doerWithToken); ok {
+		return d
+	}
+
+	key := weak.Make(c)
+	fallbackDoers.mu.Lock()
+	defer fallbackDoers.mu.Unlock()
+	if d, ok := fallbackDoers.m[key]; ok {
+		return d
+	}
+	if fallbackDoers.m == nil {
+		fallbackDoers.m = map[weak.Pointer[Client]]*doerWithToken{}
+	}
+	d := newDoerWithToken("", false)
+	fallbackDoers.m[key] = d
+	runtime.AddCleanup(c, func(key weak.Pointer[Client]) {
+		fallbackDoers.mu.Lock()
+		defer fallbackDoers.mu.Unlock()
+		delete(fallbackDoers.m, key)
+	}, key)
+	return d
 }
 
 var _ HttpRequestDoer = &doerWithToken{}
 
 func (d *doerWithToken) Do(req *http.Request) (*http.Response, error) {
-	req.Header.Set("Authorization", "Bearer "+d.apiToken)
-	client := &http.Client{}
-	if d.debug {
-		ct := httpdebug.New()
-		client = &http.Client{Transport: ct}
+	if d.closed.Load() {
+		return nil, ErrClientClosed
+	}
+	if err := d.editRequest(req); err != nil {
+		return nil, err
+	}
+	if err := d.authorize(req); err != nil {
+		return nil, err
+	}
+	do := d.doWithRetry
+	if d.tracer != nil {
+		do = d.doTraced
 	}
-	return client.Do(req)
+	resp, err := do(d.httpClient(), req)
+	if err != nil {
+		return nil, err
+	}
+	if resp, err = d.retryUnauthorized(req, resp, do); err != nil {
+		return nil, err
+	}
+	if err := d.inspectResponse(resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// copyBuffer copies src to dst using a buffer of the client's copy buffer size.
+// The io.ReaderFrom and io.WriterTo shortcuts are hidden so that the buffer
+// size is always honored.
+func (c *Client) copyBuffer(dst io.Writer, src io.Reader) (int64, error) {
+	buf := make([]byte, c.doer().copyBufferSize)
+	return io.CopyBuffer(struct{ io.Writer }{dst}, struct{ io.Reader }{src}, buf)
 }
 
-type indexEntryResponseT struct {
-	Data []struct {
-		ID       int64  `json:"id"`
-		Name     string `json:"name"`
-		FileName string `json:"file_name"`
-		ParentID int64  `json:"parent_id"`
-		Path     string `json:"path"`
-	} `json:"data"`
+// readResponse reads and closes the body of resp, then replaces it with a
+// copy of the bytes read so that callers handed resp can read it again.
+func readResponse(resp *http.Response) ([]byte, error) {
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	return body, err
 }
 
 // Ptr returns a pointer to the provided value.
@@ -69,7 +251,51 @@ func Ptr[T any](v T) *T {
 	return &v
 }
 
-// DeleteEntries deletes entries by ID.
+// ptrValue returns the value p points to, or the zero value if p is nil.
+func ptrValue[T any](p *T) T {
+	var v T
+	if p != nil {
+		v = *p
+	}
+	return v
+}
+
+// validEntryTypes mirrors the `type` enum of the indexEntry operation in api.yaml.
+var validEntryTypes = []IndexEntryParamsType{
+	IndexEntryParamsTypeFolder,
+	IndexEntryParamsTypeImage,
+	IndexEntryParamsTypeText,
+	IndexEntryParamsTypeAudio,
+	IndexEntryParamsTypeVideo,
+	IndexEntryParamsTypePdf,
+}
+
+// ValidEntryTypes returns every entry type that IndexEntry accepts as a filter.
+func ValidEntryTypes() []IndexEntryParamsType {
+	return slices.Clone(validEntryTypes)
+}
+
+// Valid reports whether t is one of ValidEntryTypes.
+func (t IndexEntryParamsType) Valid() bool {
+	return slices.Contains(validEntryTypes, t)
+}
+
+// validateEntryType returns an error if typ is non-nil and not one of ValidEntryTypes.
+func validateEntryType(typ *IndexEntryParamsType) error {
+	if typ == nil || typ.Valid() {
+		return nil
+	}
+	return fmt.Errorf("invalid entry type %q; must be one of %v", *typ, validEntryTypes)
+}
+
+// DeleteEntries deletes entries by ID, moving them to the trash.
+// Large numbers of IDs are split into several requests as set by
+// WithDeleteBatchSize; if any of them fail, the error is a *DeleteError
+// listing the IDs that were not deleted.
+// Use RestoreEntries to undo it, or DeleteEntriesForeverByID to reclaim the space immediately.
+//
+// Deprecated: Use DeleteEntriesByID, which takes the IDs as int64 like the
+// rest of the package.
 func (c *Client) DeleteEntries(ctx context.Context, ids []string) error {
 	// curl -X POST ' https://na.folderfort.com/api/v1/file-entries' \
 	// -H 'Authorization: Bearer YOUR_ACCESS_TOKEN' \
@@ -77,10 +303,124 @@ func (c *Client) DeleteEntries(ctx context.Context, ids []string) error {
 	// -H 'Content-Type: application/json' \
 	// -H 'X-HTTP-Method-Override: DELETE' \
 	// --data '{"entryIds":[12345],"deleteForever":false}'
-	log.Printf("GML: DeleteEntries(ids=%+v)", ids)
+	return c.deleteEntries(ctx, ids, false)
+}
+
+// DeleteEntriesByID is DeleteEntries for IDs given as int64, such as Entry.ID.
+func (c *Client) DeleteEntriesByID(ctx context.Context, ids []int64) error {
+	return c.deleteEntries(ctx, idStrings(ids), false)
+}
+
+// DeleteEntriesForever permanently deletes entries by ID, bypassing the trash.
+// It cannot be undone. IDs are batched as for DeleteEntries.
+//
+// Deprecated: Use DeleteEntriesForeverByID, which takes the IDs as int64 like
+// the rest of the package.
+func (c *Client) DeleteEntriesForever(ctx context.Context, ids []string) error {
+	return c.deleteEntries(ctx, ids, true)
+}
+
+// DeleteEntriesForeverByID is DeleteEntriesForever for IDs given as int64,
+// such as Entry.ID.
+func (c *Client) DeleteEntriesForeverByID(ctx context.Context, ids []int64) error {
+	return c.deleteEntries(ctx, idStrings(ids), true)
+}
+
+// DeleteFolderByPath deletes the folder at the slash-separated folderPath
+// below parentID (or the root folder if nil), together with its contents.
+// It moves the folder to the trash unless deleteForever is true.
+// Nothing is created while resolving the path: if any folder in it does not
+// exist, an error wrapping ErrParentNotFound is returned.
+func (c *Client) DeleteFolderByPath(ctx context.Context, folderPath string, parentID *int64, deleteForever bool) error {
+	if strings.Trim(folderPath, "/") == "" {
+		return errors.New("folder path must not be empty")
+	}
+	id, err := c.lookupFolderPath(ctx, folderPath, parentID)
+	if err != nil {
+		return fmt.Errorf("failed to delete folder %q: %w", folderPath, err)
+	}
+	return c.deleteEntriesBatch(ctx, idStrings([]int64{*id}), deleteForever)
+}
+
+// defaultDeleteBatchSize is the number of entries deleted per request unless
+// changed by WithDeleteBatchSize.
+const defaultDeleteBatchSize = 100
+
+// DeleteFailure is one request of a bulk delete that failed.
+type DeleteFailure struct {
+	// IDs are the entries the request was deleting.
+	IDs []string
+	// Err is the reason the request failed.
+	Err error
+}
+
+// DeleteError is returned by DeleteEntriesByID, DeleteEntriesForeverByID and
+// their deprecated string variants when some of their requests fail. The
+// entries of the other requests were deleted. It unwraps to each failure's
+// Err, so errors.As still finds an *APIError.
+type DeleteError struct {
+	Failed []DeleteFailure
+}
+
+func (e *DeleteError) Error() string {
+	msgs := make([]string, 0, len(e.Failed))
+	for _, f := range e.Failed {
+		msgs = append(msgs, f.Err.Error())
+	}
+	return strings.Join(msgs, "\n")
+}
+
+// Unwrap returns the error of each failed request.
+func (e *DeleteError) Unwrap() []error {
+	errs := make([]error, 0, len(e.Failed))
+	for _, f := range e.Failed {
+		errs = append(errs, f.Err)
+	}
+	return errs
+}
+
+// FailedIDs returns the IDs of every entry whose request failed.
+func (e *DeleteError) FailedIDs() []string {
+	var ids []string
+	for _, f := range e.Failed {
+		ids = append(ids, f.IDs...)
+	}
+	return ids
+}
+
+// deleteEntries deletes entries by ID, moving them to the trash unless
+// deleteForever is true. The IDs are sent in batches of the size set by
+// WithDeleteBatchSize, and a failed batch does not stop the others; if any
+// fail, the error is a *DeleteError. Once ctx is done, the remaining
+// batches are reported as failed without being sent.
+func (c *Client) deleteEntries(ctx context.Context, ids []string, deleteForever bool) error {
+	size := c.doer().deleteBatchSize
+	var failed []DeleteFailure
+	for start := 0; start < len(ids); start += size {
+		end := min(start+size, len(ids))
+		if err := ctx.Err(); err != nil {
+			failed = append(failed, DeleteFailure{IDs: ids[start:], Err: err})
+			break
+		}
+		if err := c.deleteEntriesBatch(ctx, ids[start:end], deleteForever); err != nil {
+			failed = append(failed, DeleteFailure{IDs: ids[start:end], Err: err})
+		}
+	}
+	if len(failed) > 0 {
+		return &DeleteError{Failed: failed}
+	}
+	return nil
+}
+
+// deleteEntriesBatch deletes entries by ID in a single request, moving them
+// to the trash unless deleteForever is true.
+func (c *Client) deleteEntriesBatch(ctx context.Context, ids []string, deleteForever bool) error {
+	c.doer().logf("DeleteEntries(ids=%+v, deleteForever=%v)", ids, deleteForever)
+	c.doer().folders.invalidate(ids)
 
 	req := EntriesDeleteJSONRequestBody{
-		EntryIds: &ids,
+		EntryIds:      &ids,
+		DeleteForever: Ptr(fmt.Sprintf("%v", deleteForever)),
 	}
 
 	resp, err := c.EntriesDelete(ctx, req)
@@ -94,7 +434,10 @@ func (c *Client) DeleteEntries(ctx context.Context, ids []string) error {
 	}
 
 	if resp.StatusCode != 200 {
-		return fmt.Errorf("failed to delete entries %+v: %s", ids, body)
+		return fmt.Errorf("failed to delete entries %+v: %w", ids, newAPIError(resp.StatusCode, body))
+	}
+	if err := checkEnvelope(resp.StatusCode, body); err != nil {
+		return err
 	}
 
 	return nil
@@ -103,8 +446,25 @@ func (c *Client) DeleteEntries(ctx context.Context, ids []string) error {
 // getEntriesByName queries FolderFort to see if one or more named entries exist within the provided parentID.
 // An optional type can be provided to narrow the search.
 func (c *Client) getEntriesByName(ctx context.Context, name string, parentID *int64, typ *IndexEntryParamsType) ([]int64, error) {
+	entries, err := c.findEntriesByName(ctx, name, parentID, typ)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]int64, 0, len(entries))
+	for _, e := range entries {
+		ids = append(ids, e.ID)
+	}
+	return ids, nil
+}
+
+// findEntriesByName is getEntriesByName but returns the matching entries.
+func (c *Client) findEntriesByName(ctx context.Context, name string, parentID *int64, typ *IndexEntryParamsType) ([]Entry, error) {
 	// log.Printf("GML: getEntriesByName(name=%q, parentID=%#v)", name, parentID)
 
+	if err := validateEntryType(typ); err != nil {
+		return nil, err
+	}
+
 	params := &IndexEntryParams{
 		Query: &name,
 		Type:  typ, // OK if nil
@@ -114,68 +474,172 @@ func (c *Client) getEntriesByName(ctx context.Context, name string, parentID *in
 		params.ParentIds = &parentIDs
 	}
 
-	resp, err := c.IndexEntry(ctx, params)
-	if err != nil {
-		return nil, fmt.Errorf("c.IndexEntry: %w", err)
-	}
-	defer resp.Body.Close()
-	body, err := io.ReadAll(resp.Body)
+	// Follow every page, or a name past the first page would look missing.
+	entries, err := c.listEntries(ctx, *params)
 	if err != nil {
-		return nil, fmt.Errorf("io.ReadAll: %w", err)
-	}
-
-	if resp.StatusCode != 200 {
-		return nil, fmt.Errorf("failed to get folder '%v': %s", name, body)
-	}
-
-	var indexEntryResp indexEntryResponseT
-	if err := json.Unmarshal(body, &indexEntryResp); err != nil {
-		return nil, fmt.Errorf("failed to parse response for folder '%v': %w\n%s", name, err, body)
+		return nil, fmt.Errorf("failed to get folder '%v': %w", name, err)
 	}
 
-	var results []int64
-	for _, v := range indexEntryResp.Data {
-		if parentID != nil && *parentID != v.ParentID {
-			log.Printf("GML: getEntriesByName: QUERY IGNORED ParentIDs!: Name=%q, ID=%v, ParentID=%v, FileName=%q, Path=%q", v.Name, v.ID, v.ParentID, v.FileName, v.Path)
+	var results []Entry
+	for _, v := range entries {
+		if !c.doer().parentMatch.inParent(v, parentID) {
+			c.doer().logf("getEntriesByName: server ignored parentIds: Name=%q, ID=%v, ParentID=%v, FileName=%q, Path=%q", v.Name, v.ID, ptrValue(v.ParentID), v.FileName, v.Path)
 			continue
 		}
-		if v.Name == name {
-			log.Printf("GML: getEntriesByName: FOUND MATCH: Name=%q, ID=%v, ParentID=%v, FileName=%q, Path=%q", v.Name, v.ID, v.ParentID, v.FileName, v.Path)
-			results = append(results, v.ID)
+		if c.doer().nameMatcher(name, v.Name) {
+			c.doer().logf("getEntriesByName: found match: Name=%q, ID=%v, ParentID=%v, FileName=%q, Path=%q", v.Name, v.ID, ptrValue(v.ParentID), v.FileName, v.Path)
+			results = append(results, v)
 		}
 	}
 
 	return results, nil
 }
 
+// NameMatcher reports whether the name of an existing entry, candidate,
+// should be treated as the same name as query when looking up entries by name.
+type NameMatcher func(query, candidate string) bool
+
+// ExactNameMatch is a NameMatcher that requires identical names.
+// Use it with WithNameMatcher if names differing only in case or in
+// surrounding whitespace should be kept apart.
+func ExactNameMatch(query, candidate string) bool {
+	return query == candidate
+}
+
+// CaseInsensitiveNameMatch is the default NameMatcher. It ignores Unicode
+// case differences and surrounding whitespace, as FolderFort does, so that
+// looking up "Photos" finds an existing "photos" folder instead of creating
+// a duplicate.
+func CaseInsensitiveNameMatch(query, candidate string) bool {
+	return strings.EqualFold(strings.TrimSpace(query), strings.TrimSpace(candidate))
+}
+
+// ParentMatch selects how entries returned by a lookup by name are checked
+// against the folder being searched, since the server does not reliably
+// honor its parent filter. See WithParentMatch.
+type ParentMatch int
+
+const (
+	// MatchParentID, the default, compares an entry's ParentID with the
+	// folder searched. Lookups in the root folder accept entries anywhere.
+	MatchParentID ParentMatch = iota
+
+	// MatchParentPath compares the folder searched with the parent recorded
+	// in an entry's Path of folder IDs, falling back to its ParentID if the
+	// Path is empty. Lookups in the root folder only accept entries whose
+	// Path places them at the root.
+	MatchParentPath
+)
+
+// inParent reports whether e is a direct child of parentID (or of the root
+// folder if nil) according to m.
+func (m ParentMatch) inParent(e Entry, parentID *int64) bool {
+	if m == MatchParentPath && e.Path != "" {
+		ids := strings.Split(e.Path, "/")
+		if len(ids) == 1 {
+			return parentID == nil
+		}
+		return parentID != nil && ids[len(ids)-2] == strconv.FormatInt(*parentID, 10)
+	}
+	if parentID == nil && m == MatchParentID {
+		return true
+	}
+	return sameParent(parentID, e.ParentID)
+}
+
 // getFolder queries FolderFort to see if the named folder exists within the provided parentID.
 // It does not support parent folders (e.g. "parent/folder-name").
-func (c *Client) getFolder(ctx context.Context, name string, parentID *int64) (*int64, error) {
+// If the folder does not exist, it returns an error wrapping ErrFolderNotFound.
+func (c *Client) getFolder(ctx context.Context, name string, parentID *int64) (*Entry, error) {
 	// log.Printf("GML: getFolder(name=%q, parentID=%#v)", name, parentID)
 
-	ids, err := c.getEntriesByName(ctx, name, parentID, Ptr(IndexEntryParamsTypeFolder))
+	entries, err := c.findEntriesByName(ctx, name, parentID, Ptr(IndexEntryParamsTypeFolder))
 	if err != nil {
 		return nil, err
 	}
 
-	if len(ids) > 0 {
-		return &ids[0], nil
+	if len(entries) > 0 {
+		return &entries[0], nil
 	}
 
-	return nil, fmt.Errorf("unable to find folder %q", name)
+	return nil, fmt.Errorf("%w: %q", ErrFolderNotFound, name)
+}
+
+// FolderExists reports whether the folder name exists within parentID (or the
+// root folder if nil) and, if so, returns its ID. If name contains parent
+// folders, they are looked up in turn, and a missing parent means the folder
+// does not exist. A missing folder is not an error; the error is reserved
+// for failed API calls. Unlike GetOrCreateFolder, it always asks the server
+// rather than trusting the folder cache.
+func (c *Client) FolderExists(ctx context.Context, name string, parentID *int64) (bool, *int64, error) {
+	if name == "" {
+		return false, nil, errors.New("name must not be empty")
+	}
+
+	parentDir, baseDir := filepath.Split(name)
+	parentDir = strings.TrimSuffix(parentDir, "/")
+	if parentDir != "" {
+		var err error
+		parentID, err = c.lookupFolderPath(ctx, parentDir, parentID)
+		if errors.Is(err, ErrParentNotFound) {
+			return false, nil, nil
+		}
+		if err != nil {
+			return false, nil, err
+		}
+		name = baseDir
+	}
+
+	folder, err := c.getFolder(ctx, name, parentID)
+	if errors.Is(err, ErrFolderNotFound) {
+		return false, nil, nil
+	}
+	if err != nil {
+		return false, nil, err
+	}
+	return true, &folder.ID, nil
 }
 
 type createFolderWithBodyResponse struct {
-	Folder struct {
-		ID int64 `json:"id"`
-	} `json:"folder"`
+	Folder Entry `json:"folder"`
 }
 
 // GetOrCreateFolder gets or creates a folder on FolderFort starting with an optional parentID.
 // On success, it returns the created folder ID. It recursively creates all intermediate folders if needed.
+// Folder IDs are cached for the lifetime of the client; see ClearFolderCache.
 func (c *Client) GetOrCreateFolder(ctx context.Context, name string, parentID *int64) (*int64, error) {
-	// log.Printf("GML: GetOrCreateFolder(name=%q, parentID=%#v)", name, parentID)
+	folder, _, err := c.getOrCreateFolder(ctx, name, parentID)
+	if err != nil {
+		return nil, err
+	}
+	return &folder.ID, nil
+}
+
+// GetOrCreateFolderEntry is like GetOrCreateFolder but returns the folder's
+// entry, including the server-side Path of folder IDs that locates it, so
+// that callers do not need another request to learn where it landed.
+// If the folder already existed, the entry only holds the folder's ID, name,
+// parent, type and path.
+func (c *Client) GetOrCreateFolderEntry(ctx context.Context, name string, parentID *int64) (*Entry, error) {
+	folder, _, err := c.getOrCreateFolder(ctx, name, parentID)
+	return folder, err
+}
+
+// GetOrCreateFolderWithResponse is like GetOrCreateFolderEntry but also
+// returns the raw response to the request that created the folder, so that
+// callers can inspect headers such as request IDs or rate limits.
+// The response's body has already been read but can be read again.
+// If the folder already existed, the response is nil.
+// When the creation request fails, its response is returned with the error.
+func (c *Client) GetOrCreateFolderWithResponse(ctx context.Context, name string, parentID *int64) (*Entry, *http.Response, error) {
+	return c.getOrCreateFolder(ctx, name, parentID)
+}
 
+// CreateFolderStrict creates the folder name within parentID (or the root
+// folder if nil) and returns its ID. Unlike GetOrCreateFolder, it returns an
+// error wrapping ErrFolderExists if the folder is already present. Missing
+// parent folders in name are created as by GetOrCreateFolder.
+func (c *Client) CreateFolderStrict(ctx context.Context, name string, parentID *int64) (*int64, error) {
 	if name == "" {
 		return nil, errors.New("name must not be empty")
 	}
@@ -185,146 +649,560 @@ func (c *Client) GetOrCreateFolder(ctx context.Context, name string, parentID *i
 	if parentDir != "" {
 		var err error
 		parentID, err = c.GetOrCreateFolder(ctx, parentDir, parentID)
-		name = baseDir
 		if err != nil {
 			return nil, fmt.Errorf("unable to create folder %q: %w", parentDir, err)
 		}
+		name = baseDir
+	}
+
+	folders := &c.doer().folders
+	unlock := folders.lock(name, parentID)
+	defer unlock()
+	if f, ok := folders.get(name, parentID); ok {
+		return nil, fmt.Errorf("%w: %q (ID %v)", ErrFolderExists, name, f.id)
+	}
+	folder, err := c.getFolder(ctx, name, parentID)
+	if err == nil {
+		folders.put(name, parentID, cachedFolder{id: folder.ID, path: folder.Path})
+		return nil, fmt.Errorf("%w: %q (ID %v)", ErrFolderExists, name, folder.ID)
+	}
+	if !errors.Is(err, ErrFolderNotFound) {
+		return nil, fmt.Errorf("failed to look up folder %q: %w", name, err)
+	}
+
+	folder, _, err = c.createFolder(ctx, name, parentID)
+	if err != nil {
+		return nil, err
+	}
+	return &folder.ID, nil
+}
+
+// getOrCreateFolder implements GetOrCreateFolderWithResponse.
+func (c *Client) getOrCreateFolder(ctx context.Context, name string, parentID *int64) (*Entry, *http.Response, error) {
+	// log.Printf("GML: GetOrCreateFolder(name=%q, parentID=%#v)", name, parentID)
+
+	if name == "" {
+		return nil, nil, errors.New("name must not be empty")
+	}
+
+	parentDir, baseDir := filepath.Split(name)
+	parentDir = strings.TrimSuffix(parentDir, "/")
+	if parentDir != "" {
+		var err error
+		parentID, err = c.GetOrCreateFolder(ctx, parentDir, parentID)
+		name = baseDir
+		if err != nil {
+			return nil, nil, fmt.Errorf("unable to create folder %q: %w", parentDir, err)
+		}
+	}
+
+	existing := func(f cachedFolder) *Entry {
+		return &Entry{ID: f.id, Name: name, ParentID: parentID, Path: f.path, Type: FileEntryTypeFolder}
 	}
 
 	// Check to see if this folder already exists. If not, create it.
-	if id, err := c.getFolder(ctx, name, parentID); err == nil {
-		return id, nil
+	folders := &c.doer().folders
+	if f, ok := folders.get(name, parentID); ok {
+		return existing(f), nil, nil
+	}
+	unlock := folders.lock(name, parentID)
+	defer unlock()
+	if f, ok := folders.get(name, parentID); ok {
+		return existing(f), nil, nil // created by a concurrent caller while we waited
 	}
+	folder, err := c.getFolder(ctx, name, parentID)
+	if err == nil {
+		folders.put(name, parentID, cachedFolder{id: folder.ID, path: folder.Path})
+		return folder, nil, nil
+	}
+	if !errors.Is(err, ErrFolderNotFound) {
+		return nil, nil, fmt.Errorf("failed to look up folder %q: %w", name, err)
+	}
+	return c.createFolder(ctx, name, parentID)
+}
 
+// createFolder creates the folder name within parentID, without checking
+// whether it already exists, and caches its ID.
+func (c *Client) createFolder(ctx context.Context, name string, parentID *int64) (*Entry, *http.Response, error) {
 	payload := map[string]interface{}{
 		"name": name,
 	}
 	if parentID != nil {
 		payload["parentId"] = *parentID
 	}
+	if ws := c.doer().workspaceID; ws != nil {
+		payload["workspaceId"] = *ws
+	}
+
+	// As with the upload delay, a rate limiter replaces the pause.
+	if d := c.doer(); d.limiter == nil {
+		if err := sleepContext(ctx, d.folderDelay); err != nil {
+			return nil, nil, fmt.Errorf("folder %q not created: %w", name, err)
+		}
+	}
 
 	payloadBytes, err := json.Marshal(payload)
 	if err != nil {
-		return nil, fmt.Errorf("failed to marshal payload for folder '%v': %w", name, err)
+		return nil, nil, fmt.Errorf("failed to marshal payload for folder '%v': %w", name, err)
 	}
 
 	resp, err := c.CreateFolderWithBody(ctx, "application/json", bytes.NewBuffer(payloadBytes))
 	if err != nil {
-		return nil, fmt.Errorf("failed to create folder '%v': %w", name, err)
+		return nil, nil, fmt.Errorf("failed to create folder '%v': %w", name, err)
 	}
-	defer resp.Body.Close()
-	body, err := io.ReadAll(resp.Body)
+	body, err := readResponse(resp)
 	if err != nil {
-		return nil, fmt.Errorf("io.ReadAll: %w", err)
+		return nil, resp, fmt.Errorf("io.ReadAll: %w", err)
 	}
 
 	if resp.StatusCode != 200 {
-		return nil, fmt.Errorf("failed to create folder '%v': %s", name, body)
+		return nil, resp, fmt.Errorf("failed to create folder '%v': %w", name, newAPIError(resp.StatusCode, body))
+	}
+	if err := checkEnvelope(resp.StatusCode, body); err != nil {
+		return nil, resp, err
 	}
 
 	var folderResp createFolderWithBodyResponse
 	if err := json.Unmarshal(body, &folderResp); err != nil {
-		return nil, fmt.Errorf("failed to parse response for folder '%v': %w\n%s", name, err, body)
+		return nil, resp, fmt.Errorf("failed to parse response for folder '%v': %w\n%s", name, err, body)
 	}
 
-	folderID := folderResp.Folder.ID
-	return &folderID, nil
+	folder := &folderResp.Folder
+	c.doer().folders.put(name, parentID, cachedFolder{id: folder.ID, path: folder.Path})
+	c.doer().slog.InfoContext(ctx, "folder created", "name", name, "id", folder.ID, "parent_id", ptrValue(parentID))
+	return folder, resp, nil
+}
+
+// FolderRef is one folder of a path resolved by GetOrCreateFolderPath.
+type FolderRef struct {
+	Name string
+	ID   int64
+	// Path is the folder's Entry.Path, the server-side path of folder IDs.
+	Path string
+}
+
+// GetOrCreateFolderPath is like GetOrCreateFolder but returns every folder
+// along the slash-separated folderPath, from the outermost to the leaf,
+// rather than only the leaf.
+func (c *Client) GetOrCreateFolderPath(ctx context.Context, folderPath string, parentID *int64) ([]FolderRef, error) {
+	var refs []FolderRef
+	for _, name := range strings.Split(folderPath, "/") {
+		if name == "" {
+			continue
+		}
+		folder, _, err := c.getOrCreateFolder(ctx, name, parentID)
+		if err != nil {
+			return refs, err
+		}
+		refs = append(refs, FolderRef{Name: name, ID: folder.ID, Path: folder.Path})
+		parentID = &folder.ID
+	}
+	if len(refs) == 0 {
+		return nil, errors.New("folder path must not be empty")
+	}
+	return refs, nil
+}
+
+// ErrParentNotFound is returned by UploadFile when a parent folder named in
+// fileName does not exist and WithAutoCreateParents(false) is in effect.
+var ErrParentNotFound = errors.New("parent folder not found")
+
+// lookupFolderPath resolves the slash-separated folderPath starting at parentID
+// (or the root folder if nil) without creating anything.
+// It returns ErrParentNotFound if any folder in the path does not exist.
+func (c *Client) lookupFolderPath(ctx context.Context, folderPath string, parentID *int64) (*int64, error) {
+	for _, name := range strings.Split(folderPath, "/") {
+		if name == "" {
+			continue
+		}
+		ids, err := c.getEntriesByName(ctx, name, parentID, Ptr(IndexEntryParamsTypeFolder))
+		if err != nil {
+			return nil, err
+		}
+		if len(ids) == 0 {
+			return nil, fmt.Errorf("%w: %q", ErrParentNotFound, folderPath)
+		}
+		parentID = &ids[0]
+	}
+	return parentID, nil
 }
 
 // UploadFileFromPath uploads a file to FolderFort using the provided contentType and folder parentID (or nil for root folder).
-// It guesses the mimeType based on the extension of the filePath or defaults to "application/octet-stream".
+// It guesses the mimeType based on the extension of the filePath (see WithMIMETypes) or, failing that,
+// by sniffing the start of the file's content, defaulting to "application/octet-stream".
 // If overwrite is true, then any existing files of the same name in the same folder will first be deleted.
 func (c *Client) UploadFileFromPath(ctx context.Context, filePath string, parentID *int64, overwrite bool) error {
-	file, err := os.Open(filePath)
+	_, err := c.uploadFileFromPath(ctx, nil, filePath, "", "", parentID, overwrite, nil)
+	return err
+}
+
+// uploadFileFromPath is UploadFileFromPath but also returns the new entry,
+// and reads filePath from fsys unless fsys is nil.
+// The file is uploaded as name, or as the base name of filePath if name is
+// empty. If mimeType is empty, it is guessed as described for
+// UploadFileFromPath. known is passed on to uploadFileSized.
+func (c *Client) uploadFileFromPath(ctx context.Context, fsys fs.FS, filePath, name, mimeType string, parentID *int64, overwrite bool, known []int64) (*UploadResult, error) {
+	file, err := openFile(fsys, filePath)
 	if err != nil {
-		return fmt.Errorf("error opening file %v: %w", filePath, err)
+		return nil, fmt.Errorf("error opening file %v: %w", filePath, err)
 	}
 	defer file.Close()
 
+	var modTime time.Time
+	if c.doer().preserveModTime {
+		info, err := file.Stat()
+		if err != nil {
+			return nil, fmt.Errorf("error getting file info for %v: %w", filePath, err)
+		}
+		modTime = info.ModTime()
+	}
+
 	// Add file field
-	fileName := filepath.Base(filePath)
-	mimeType := mime.TypeByExtension(filepath.Ext(filePath))
+	fileName := name
+	if fileName == "" {
+		fileName = filepath.Base(filePath)
+	}
 	if mimeType == "" {
-		mimeType = "application/octet-stream"
+		mimeType, _ = c.doer().lookupMIMEType(filePath)
+	}
+
+	result, _, err := c.uploadFileSized(ctx, fileName, file, -1, modTime, mimeType, parentID, overwrite, known)
+	return result, err
+}
+
+// openFile opens name within fsys, or the local file name if fsys is nil.
+func openFile(fsys fs.FS, name string) (fs.File, error) {
+	if fsys == nil {
+		return os.Open(name)
 	}
+	return fsys.Open(name)
+}
 
-	return c.UploadFile(ctx, fileName, file, mimeType, parentID, overwrite)
+type uploadWithBodyResponse struct {
+	FileEntry struct {
+		Entry
+		SHA256 string `json:"sha256"`
+	} `json:"fileEntry"`
+}
+
+// UploadResult describes a successfully uploaded file.
+type UploadResult struct {
+	// ID is the ID of the new entry.
+	ID int64
+	// Name is the name of the new entry as stored by the server.
+	Name string
+	// Size is the size in bytes reported by the server.
+	Size int64
+	// MIME is the content type recorded by the server.
+	MIME string
+	// URL is the server-relative URL for previewing the new entry.
+	URL string
+	// SHA256 is the hex-encoded SHA-256 checksum of the uploaded bytes.
+	// It is only computed when WithChecksumVerification is enabled.
+	SHA256 string
 }
 
 // UploadFile uploads a file to FolderFort using the provided contentType and folder parentID (or nil for root folder).
 // If fileName contains parent folder(s), it recursively creates all intermediate folders if needed.
+// If mimeType is empty, it is detected from the first 512 bytes of r.
 // If overwrite is true, then any existing files of the same name in the same folder will first be deleted.
 func (c *Client) UploadFile(ctx context.Context, fileName string, r io.Reader, mimeType string, parentID *int64, overwrite bool) error {
+	_, err := c.uploadFile(ctx, fileName, r, mimeType, parentID, overwrite)
+	return err
+}
+
+// UploadReaderSized is like UploadFileWithResult for content of exactly size
+// bytes. Rather than buffering the content to measure it, the request body
+// is streamed from r with its Content-Length set, which suits large files
+// and proxies that reject chunked uploads. Since r cannot be rewound, a
+// failed request is not retried. It fails if r holds fewer than size bytes,
+// and reads no further than size bytes.
+func (c *Client) UploadReaderSized(ctx context.Context, fileName string, r io.Reader, size int64, mimeType string, parentID *int64, overwrite bool) (*UploadResult, error) {
+	if size < 0 {
+		return nil, fmt.Errorf("invalid size %v", size)
+	}
+	result, _, err := c.uploadFileSized(ctx, fileName, r, size, time.Time{}, mimeType, parentID, overwrite, nil)
+	return result, err
+}
+
+// sizedReader reads exactly n bytes from r, failing with
+// io.ErrUnexpectedEOF if r ends sooner.
+type sizedReader struct {
+	r io.Reader
+	n int64
+}
+
+func (s *sizedReader) Read(p []byte) (int, error) {
+	if s.n <= 0 {
+		return 0, io.EOF
+	}
+	if int64(len(p)) > s.n {
+		p = p[:s.n]
+	}
+	n, err := s.r.Read(p)
+	s.n -= int64(n)
+	if err == io.EOF && s.n > 0 {
+		return n, fmt.Errorf("content is %v bytes shorter than its declared size: %w", s.n, io.ErrUnexpectedEOF)
+	}
+	if err == io.EOF {
+		err = nil
+	}
+	return n, err
+}
+
+// UploadFileWithResult is like UploadFile but also returns the new entry.
+func (c *Client) UploadFileWithResult(ctx context.Context, fileName string, r io.Reader, mimeType string, parentID *int64, overwrite bool) (*UploadResult, error) {
+	return c.uploadFile(ctx, fileName, r, mimeType, parentID, overwrite)
+}
+
+// UploadFileWithResponse is like UploadFileWithResult but also returns the
+// raw response to the upload request, so that callers can inspect headers
+// such as request IDs or rate limits. The response's body has already been
+// read but can be read again. When the upload request was sent but failed,
+// its response is returned with the error; otherwise the response is nil.
+func (c *Client) UploadFileWithResponse(ctx context.Context, fileName string, r io.Reader, mimeType string, parentID *int64, overwrite bool) (*UploadResult, *http.Response, error) {
+	return c.uploadFileSized(ctx, fileName, r, -1, time.Time{}, mimeType, parentID, overwrite, nil)
+}
+
+// uploadFile is UploadFile but also returns the new entry.
+func (c *Client) uploadFile(ctx context.Context, fileName string, r io.Reader, mimeType string, parentID *int64, overwrite bool) (*UploadResult, error) {
+	result, _, err := c.uploadFileSized(ctx, fileName, r, -1, time.Time{}, mimeType, parentID, overwrite, nil)
+	return result, err
+}
+
+// overwriteTargets returns the IDs of the entries that an upload of fileName
+// into parentID (or the root folder if nil) with overwrite replaces.
+// Deleting the wrong entry cannot be undone by the upload, so unlike other
+// lookups by name it ignores WithNameMatcher and WithParentMatch; see
+// isOverwriteTarget.
+func (c *Client) overwriteTargets(ctx context.Context, fileName string, parentID *int64) ([]int64, error) {
+	entries, err := c.listFolderWithParams(ctx, parentID, IndexEntryParams{Query: &fileName})
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up file %q: %w", fileName, err)
+	}
+	var ids []int64
+	for _, e := range entries {
+		if isOverwriteTarget(e, fileName, parentID) {
+			ids = append(ids, e.ID)
+		}
+	}
+	return ids, nil
+}
+
+// isOverwriteTarget reports whether e is a file named exactly name directly
+// within parentID (or the root folder if nil). Folders, names differing in
+// case or whitespace, and entries elsewhere on the drive never match.
+func isOverwriteTarget(e Entry, name string, parentID *int64) bool {
+	return !e.IsFolder() && e.Name == name && sameParent(e.ParentID, parentID)
+}
+
+// uploadFileSized is UploadFileWithResponse for content of knownSize bytes,
+// or of unknown size if knownSize is negative. A non-zero modTime is sent as
+// the file's modification time if WithPreserveModTime is enabled.
+// If overwrite is set and known is non-nil, known holds the IDs of the
+// files to replace, as already found by the caller, and no lookup is made.
+func (c *Client) uploadFileSized(ctx context.Context, fileName string, r io.Reader, knownSize int64, modTime time.Time, mimeType string, parentID *int64, overwrite bool, known []int64) (*UploadResult, *http.Response, error) {
 	// log.Printf("GML: UploadFile(fileName=%q, mimeType=%q, parentID=%#v)", fileName, mimeType, parentID)
 
 	if fileName == "" {
-		return errors.New("fileName must not be empty")
+		return nil, nil, errors.New("fileName must not be empty")
+	}
+	if mimeType == "" {
+		var err error
+		if mimeType, r, err = sniffMIMEType(r); err != nil {
+			return nil, nil, fmt.Errorf("error reading file %v: %w", fileName, err)
+		}
 	}
 
 	parentDir, baseName := filepath.Split(fileName)
 	parentDir = strings.TrimSuffix(parentDir, "/")
 	if parentDir != "" {
 		var err error
-		parentID, err = c.GetOrCreateFolder(ctx, parentDir, parentID)
-		fileName = baseName
-		if err != nil {
-			return fmt.Errorf("unable to create folder %q: %w", parentDir, err)
+		if !c.doer().autoCreateParents {
+			if parentID, err = c.lookupFolderPath(ctx, parentDir, parentID); err != nil {
+				return nil, nil, err
+			}
+		} else if parentID, err = c.GetOrCreateFolder(ctx, parentDir, parentID); err != nil {
+			return nil, nil, fmt.Errorf("unable to create folder %q: %w", parentDir, err)
 		}
+		fileName = baseName
 	}
 
-	if overwrite {
-		ids, err := c.getEntriesByName(ctx, fileName, parentID, nil)
+	maxSize := c.maxUploadSize(ctx)
+	if knownSize >= 0 && maxSize > 0 && knownSize > maxSize {
+		return nil, nil, fmt.Errorf("%w: %q is larger than %v bytes", ErrFileTooLarge, fileName, maxSize)
+	}
+
+	var replaced []int64 // the files being overwritten
+	if overwrite && known != nil {
+		replaced = known
+	} else if overwrite {
+		ids, err := c.overwriteTargets(ctx, fileName, parentID)
 		if err != nil {
-			log.Printf("getEntriesByName: %v (ignoring)", err)
-		} else if len(ids) > 0 {
-			strIDs := make([]string, 0, len(ids))
-			for _, id := range ids {
-				strIDs = append(strIDs, fmt.Sprintf("%v", id))
-			}
-			if err := c.DeleteEntries(ctx, strIDs); err != nil {
-				log.Printf("c.DeleteEntries(ids=%+v): %v (ignoring)", ids, err)
-			}
+			c.doer().logf("overwriteTargets: %v (ignoring)", err)
 		}
+		replaced = ids
 	}
 
 	// Create a buffer to store our request body
 	var requestBody bytes.Buffer
-	writer := io.Writer(&requestBody)
-
-	// Create multipart form
-	boundary := "----WebKitFormBoundary7MA4YWxkTrZu0gW"
+	writer := multipart.NewWriter(&requestBody)
 
 	// Add parentId field if provided
 	if parentID != nil {
-		fmt.Fprintf(writer, "--%v\r\n", boundary)
-		fmt.Fprintf(writer, "Content-Disposition: form-data; name=\"parentId\"\r\n\r\n")
-		fmt.Fprintf(writer, "%v\r\n", *parentID)
+		if err := writer.WriteField("parentId", fmt.Sprintf("%v", *parentID)); err != nil {
+			return nil, nil, err
+		}
+	}
+	if ws := c.doer().workspaceID; ws != nil {
+		if err := writer.WriteField("workspaceId", fmt.Sprintf("%v", *ws)); err != nil {
+			return nil, nil, err
+		}
+	}
+	if c.doer().preserveModTime && !modTime.IsZero() {
+		if err := writer.WriteField("lastModified", fmt.Sprintf("%v", modTime.UnixMilli())); err != nil {
+			return nil, nil, err
+		}
 	}
 
-	fmt.Fprintf(writer, "--%v\r\n", boundary)
-	fmt.Fprintf(writer, "Content-Disposition: form-data; name=\"file\"; filename=\"%v\"\r\n", fileName)
-	fmt.Fprintf(writer, "Content-Type: %v\r\n\r\n", mimeType)
+	compress := c.doer().compressUploads && compressible(mimeType)
+	if compress && knownSize >= 0 {
+		// The compressed size is not known in advance.
+		r, knownSize = &sizedReader{r: r, n: knownSize}, -1
+	}
 
-	// Copy file content
-	if _, err := io.Copy(writer, r); err != nil {
-		return fmt.Errorf("error copying file content: %w", err)
+	header := textproto.MIMEHeader{}
+	header.Set("Content-Disposition", multipart.FileContentDisposition("file", fileName))
+	header.Set("Content-Type", mimeType)
+	if compress {
+		header.Set("Content-Encoding", "gzip")
+	}
+	part, err := writer.CreatePart(header)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	fmt.Fprintf(writer, "\r\n--%v--\r\n", boundary)
+	// Copy file content, hashing it on the way if requested
+	var hasher hash.Hash
+	if c.doer().verifyChecksum {
+		hasher = sha256.New()
+		r = io.TeeReader(r, hasher)
+	}
+	var requestReader io.Reader = &requestBody
+	var editors []RequestEditorFn
+	size := knownSize
+	if knownSize >= 0 {
+		// Stream the content between the multipart framing, so the request
+		// can declare its length without holding the file in memory.
+		head := bytes.Clone(requestBody.Bytes())
+		requestBody.Reset()
+		if err := writer.Close(); err != nil {
+			return nil, nil, err
+		}
+		tail := requestBody.Bytes()
+		requestReader = io.MultiReader(bytes.NewReader(head), &sizedReader{r: r, n: knownSize}, bytes.NewReader(tail))
+		length := int64(len(head)) + knownSize + int64(len(tail))
+		editors = append(editors, func(ctx context.Context, req *http.Request) error {
+			req.ContentLength = length
+			return nil
+		})
+	} else {
+		if maxSize > 0 {
+			r = io.LimitReader(r, maxSize+1)
+		}
+		dst := io.Writer(part)
+		var gz *gzip.Writer
+		if compress {
+			gz = gzip.NewWriter(part)
+			dst = gz
+		}
+		if size, err = c.copyBuffer(dst, r); err != nil {
+			return nil, nil, fmt.Errorf("error copying file content: %w", err)
+		}
+		if gz != nil {
+			if err := gz.Close(); err != nil {
+				return nil, nil, fmt.Errorf("error compressing file content: %w", err)
+			}
+		}
+		if maxSize > 0 && size > maxSize {
+			return nil, nil, fmt.Errorf("%w: %q is larger than %v bytes", ErrFileTooLarge, fileName, maxSize)
+		}
+		if err := writer.Close(); err != nil {
+			return nil, nil, err
+		}
+	}
 
-	contentType := "multipart/form-data; boundary=" + boundary
-	resp, err := c.UploadWithBody(ctx, contentType, &requestBody)
+	contentType := writer.FormDataContentType()
+	logger := c.doer().slog
+	logger.DebugContext(ctx, "upload started", "name", fileName, "parent_id", ptrValue(parentID), "bytes", size)
+	start := time.Now()
+	if c.doer().idempotencyKeys {
+		key, err := newIdempotencyKey()
+		if err != nil {
+			return nil, nil, err
+		}
+		editors = append(editors, func(ctx context.Context, req *http.Request) error {
+			req.Header.Set(idempotencyKeyHeader, key)
+			return nil
+		})
+	}
+	if fn := c.doer().progress; fn != nil {
+		editors = append(editors, progressEditor(fn))
+	}
+	if limiter := c.doer().bandwidth; limiter != nil {
+		editors = append(editors, bandwidthEditor(limiter))
+	}
+	if len(replaced) > 0 && !c.doer().atomicReplace {
+		// Only delete once the content is known to be within the size
+		// limit, so that an oversize file does not cost the existing one.
+		if err := c.DeleteEntriesByID(ctx, replaced); err != nil {
+			c.doer().logf("c.DeleteEntriesByID(ids=%+v): %v (ignoring)", replaced, err)
+		}
+		replaced = nil
+	}
+	resp, err := c.UploadWithBody(ctx, contentType, requestReader, editors...)
 	if err != nil {
-		return fmt.Errorf("failed to upload file: %w", err)
+		return nil, nil, fmt.Errorf("failed to upload file: %w", err)
+	}
+	body, err := readResponse(resp)
+	if err != nil {
+		return nil, resp, fmt.Errorf("io.ReadAll: %w", err)
 	}
-	defer resp.Body.Close()
 
 	if resp.StatusCode != 201 {
-		body, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("failed to upload file: %s", body)
+		return nil, resp, fmt.Errorf("failed to upload file: %w", newAPIError(resp.StatusCode, body))
+	}
+	if err := checkEnvelope(resp.StatusCode, body); err != nil {
+		return nil, resp, err
 	}
 
-	return nil
+	var uploadResp uploadWithBodyResponse
+	if err := json.Unmarshal(body, &uploadResp); err != nil {
+		return nil, resp, fmt.Errorf("failed to parse response for file '%v': %w\n%s", fileName, err, body)
+	}
+
+	entry := uploadResp.FileEntry.Entry
+	result := &UploadResult{
+		ID:   entry.ID,
+		Name: entry.Name,
+		Size: entry.Size,
+		MIME: entry.MIME,
+		URL:  entry.URL,
+	}
+	logger.InfoContext(ctx, "upload completed", "name", fileName, "id", result.ID, "bytes", size, "duration", time.Since(start))
+	if hasher != nil {
+		result.SHA256 = hex.EncodeToString(hasher.Sum(nil))
+		if err := c.verifyChecksum(fileName, result.SHA256, uploadResp.FileEntry.SHA256); err != nil {
+			return result, resp, err
+		}
+	}
+	if len(replaced) > 0 { // atomicReplace
+		if err := c.replaceEntries(ctx, result, fileName, replaced); err != nil {
+			return result, resp, err
+		}
+	}
+
+	return result, resp, nil
 }
 
 func shouldExclude(path string, excludePatterns []string) bool {
@@ -336,60 +1214,393 @@ func shouldExclude(path string, excludePatterns []string) bool {
 	return false
 }
 
+// DefaultExcludeNames are the file and folder names skipped by UploadDirectory
+// when no excludePatterns are provided, and by UploadDirectoryWithOptions unless
+// UploadOptions.NoDefaultExcludes is set. Unlike excludePatterns, they must match
+// an entry's base name exactly, so ".git" skips ".git/" but not ".github/".
+var DefaultExcludeNames = []string{".git", "__pycache__", ".DS_Store", ".env", "venv", "node_modules"}
+
+// UploadOptions configures UploadDirectoryWithOptions.
+type UploadOptions struct {
+	// ExcludePatterns skips any local path containing one of these substrings.
+	ExcludePatterns []string
+
+	// ExcludeNames skips every file and folder with one of these exact base
+	// names, in addition to DefaultExcludeNames.
+	ExcludeNames []string
+
+	// NoDefaultExcludes stops DefaultExcludeNames from being skipped.
+	NoDefaultExcludes bool
+
+	// GitignorePatterns skips paths matching these .gitignore-style patterns
+	// (e.g. "*.log", "build/" or "!keep.log"), matched against each path
+	// relative to the uploaded directory. They apply in addition to ExcludePatterns.
+	GitignorePatterns []string
+
+	// UseGitignoreFile also reads patterns from a .gitignore file at the top
+	// of the uploaded directory, if there is one. GitignorePatterns are
+	// applied after it, so they can override it with negations.
+	UseGitignoreFile bool
+
+	// ExcludeDotfiles skips every file and folder whose name starts with ".".
+	ExcludeDotfiles bool
+
+	// FollowSymlinks uploads the targets of symbolic links as if they were
+	// regular files and folders. Links that would loop back into a folder
+	// being uploaded are skipped. By default, all symlinks are skipped.
+	FollowSymlinks bool
+
+	// SkipUnchanged skips uploading a file when the destination folder already
+	// has a file of the same name and size that was last updated no earlier
+	// than the local file was modified, turning repeated uploads into an
+	// incremental sync.
+	SkipUnchanged bool
+
+	// ForceOverwrite uploads every file even if SkipUnchanged is set.
+	ForceOverwrite bool
+
+	// ErrorOnTooLarge stops the upload with ErrFileTooLarge when a file exceeds
+	// the upload size limit (see WithMaxFileSize), instead of skipping it.
+	ErrorOnTooLarge bool
+
+	// CheckQuota walks the directory before uploading anything and fails
+	// with ErrInsufficientQuota if the files that would be uploaded exceed
+	// the remaining storage reported by StorageSummary.
+	CheckQuota bool
+
+	// WriteMetadata uploads a MetadataFileName file alongside the tree that
+	// records the mode and modification time of every uploaded path, so that
+	// DownloadOptions.ApplyMetadata can restore them.
+	WriteMetadata bool
+
+	// ContinueOnError keeps going when a file fails to upload, recording it
+	// in Stats.Failed instead. The failures are returned joined together
+	// once every other file has been attempted.
+	ContinueOnError bool
+
+	// FailureFile, if not empty, is the local path of a JSON file listing
+	// the uploads that failed under ContinueOnError. It is only written when
+	// there are failures, and can be read with ReadFailureFile and passed
+	// to UploadFiles to retry just those files.
+	FailureFile string
+
+	// OnFileComplete, if not nil, is called after each file upload is attempted
+	// with the file's path relative to the uploaded directory and the upload's
+	// error, if any. With WithConcurrency, it may be called from several
+	// goroutines at once. See also WithUploadProgress.
+	OnFileComplete func(relPath string, err error)
+
+	// MIMETypeFunc, if not nil, returns the content type to store for the
+	// local file at path, for example to force "text/markdown" for ".md"
+	// files. When it returns "", the type is guessed from the extension and
+	// content as by UploadFileFromPath.
+	MIMETypeFunc func(path string) string
+}
+
+// mimeType returns the content type chosen by MIMETypeFunc for the local file
+// at path, or "" to let the upload guess it.
+func (o *UploadOptions) mimeType(path string) string {
+	if o.MIMETypeFunc == nil {
+		return ""
+	}
+	return o.MIMETypeFunc(path)
+}
+
+// excluded reports whether the entry with the given base name at path should be skipped.
+func (o *UploadOptions) excluded(name, path string) bool {
+	if o.ExcludeDotfiles && strings.HasPrefix(name, ".") {
+		return true
+	}
+	if !o.NoDefaultExcludes && slices.Contains(DefaultExcludeNames, name) {
+		return true
+	}
+	if slices.Contains(o.ExcludeNames, name) {
+		return true
+	}
+	return shouldExclude(path, o.ExcludePatterns)
+}
+
 // UploadDirectory uploads the contents of a directory to FolderFort.
 // If any filename already exists, it is overwritten.
+// If excludePatterns is nil, DefaultExcludeNames are skipped instead; use
+// UploadDirectoryWithOptions to combine the defaults with other exclusions.
 func (c *Client) UploadDirectory(ctx context.Context, directoryPath string, parentID *int64, excludePatterns []string) error {
-	if excludePatterns == nil {
-		excludePatterns = []string{".git", "__pycache__", ".DS_Store", ".env", "venv", "node_modules"}
+	opts := &UploadOptions{ExcludePatterns: excludePatterns, NoDefaultExcludes: excludePatterns != nil}
+	_, err := c.UploadDirectoryWithOptions(ctx, directoryPath, parentID, opts)
+	return err
+}
+
+// UploadDirectoryWithOptions uploads the contents of a directory to FolderFort.
+// If any filename already exists, it is overwritten. A nil opts uses the defaults.
+// The returned Stats are populated even when an error is returned part way through.
+func (c *Client) UploadDirectoryWithOptions(ctx context.Context, directoryPath string, parentID *int64, opts *UploadOptions) (*Stats, error) {
+	if opts == nil {
+		opts = &UploadOptions{}
 	}
+	return c.uploadTree(ctx, nil, directoryPath, parentID, opts)
+}
 
-	entries, err := os.ReadDir(directoryPath)
+// uploadTree uploads the tree rooted at directoryPath within fsys, or on the
+// local disk if fsys is nil, into the folder parentID.
+func (c *Client) uploadTree(ctx context.Context, fsys fs.FS, directoryPath string, parentID *int64, opts *UploadOptions) (*Stats, error) {
+	ex, err := opts.newExcluder(fsys, directoryPath)
+	if err != nil {
+		return &Stats{IDs: map[string]int64{}}, err
+	}
+	if opts.CheckQuota {
+		if err := c.checkQuota(ctx, directoryPath, opts); err != nil {
+			return &Stats{IDs: map[string]int64{}}, err
+		}
+	}
+
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(c.doer().concurrency)
+	u := &dirUploader{c: c, fsys: fsys, opts: opts, ex: ex, stats: &Stats{IDs: map[string]int64{}}, g: g, ancestors: map[string]bool{}}
+	err = u.upload(gctx, directoryPath, "", parentID, false)
+	if werr := g.Wait(); werr != nil {
+		err = werr // a failed worker cancels gctx, so its error is the cause
+	}
+	if err == nil && opts.WriteMetadata {
+		err = c.uploadMetadata(ctx, u.metadata, parentID)
+	}
+	if err == nil {
+		err = errors.Join(u.errs...)
+	}
+	if ferr := writeFailureFile(opts.FailureFile, u.stats.Failed); ferr != nil {
+		err = errors.Join(err, ferr)
+	}
+	return u.stats, err
+}
+
+// dirUploader holds the state of a single UploadDirectoryWithOptions or
+// UploadFSWithOptions call. Folders are created by the goroutine walking the
+// tree, so that concurrent GetOrCreateFolder calls never race, while files
+// are uploaded by g's workers.
+type dirUploader struct {
+	c        *Client
+	fsys     fs.FS // the tree is read from fsys, or the local disk if nil
+	opts     *UploadOptions
+	ex       *excluder
+	g        *errgroup.Group
+	metadata []FileMetadata // collected when opts.WriteMetadata is set
+
+	// ancestors holds the real paths of the directories being uploaded,
+	// to detect symlink loops when opts.FollowSymlinks is set.
+	ancestors map[string]bool
+
+	mu    sync.Mutex // guards stats and errs, which workers update
+	stats *Stats
+	errs  []error // upload failures when opts.ContinueOnError is set
+}
+
+// upload uploads directoryPath, whose path relative to the top-level
+// directory is relPath, into the folder parentID, which is known to be empty
+// if created is true.
+func (u *dirUploader) upload(ctx context.Context, directoryPath, relPath string, parentID *int64, created bool) error {
+	c, opts, stats := u.c, u.opts, u.stats
+
+	entries, err := u.readDir(directoryPath)
 	if err != nil {
 		return fmt.Errorf("error reading directory %v: %w", directoryPath, err)
 	}
 
+	if opts.FollowSymlinks {
+		realPath, err := filepath.EvalSymlinks(directoryPath)
+		if err != nil {
+			return fmt.Errorf("error resolving directory %v: %w", directoryPath, err)
+		}
+		u.ancestors[realPath] = true
+		defer delete(u.ancestors, realPath)
+	}
+
+	// List the destination folder once, so that subfolders and files to
+	// overwrite are resolved from it rather than with a lookup per entry.
+	var remote []Entry
+	if !created {
+		if remote, err = c.listFolder(ctx, parentID); err != nil {
+			return err
+		}
+	}
+
 	for _, entry := range entries {
-		itemPath := filepath.Join(directoryPath, entry.Name())
+		if err := ctx.Err(); err != nil {
+			return fmt.Errorf("upload of %v stopped: %w", directoryPath, err)
+		}
 
-		// Skip excluded patterns
-		if shouldExclude(itemPath, excludePatterns) {
+		itemPath := u.join(directoryPath, entry.Name())
+		itemRelPath := path.Join(relPath, entry.Name())
+
+		info, err := entry.Info()
+		if err != nil {
+			c.doer().logf("Error getting file info for %v: %v", itemPath, err)
 			continue
 		}
 
-		// Skip large files (100MB limit)
-		if !entry.IsDir() {
-			info, err := entry.Info()
-			if err != nil {
-				log.Printf("Error getting file info for %v: %v\n", itemPath, err)
+		// Skip symlinks, or resolve them to their targets
+		if info.Mode()&fs.ModeSymlink != 0 {
+			if info, err = followSymlink(itemPath, opts.FollowSymlinks, u.ancestors); err != nil {
+				c.doer().logf("Skipping symlink: %v (%v)", itemPath, err)
+				u.skip(itemRelPath, SkippedSymlink)
 				continue
 			}
-			if info.Size() > 100*1024*1024 {
-				log.Printf("Skipping large file: %v (%.2f MB)\n", itemPath, float64(info.Size())/(1024*1024))
+		}
+
+		// Skip excluded patterns
+		if u.ex.excluded(entry.Name(), itemPath, itemRelPath, info.IsDir()) {
+			u.skip(itemRelPath, SkippedExcluded)
+			continue
+		}
+
+		// Skip files larger than the upload size limit
+		if !info.IsDir() {
+			if maxSize := c.maxUploadSize(ctx); maxSize > 0 && info.Size() > maxSize {
+				if opts.ErrorOnTooLarge {
+					return fmt.Errorf("%w: %v is %v bytes, limit is %v", ErrFileTooLarge, itemPath, info.Size(), maxSize)
+				}
+				c.doer().logf("Skipping large file: %v (%.2f MB)", itemPath, float64(info.Size())/(1024*1024))
+				u.skip(itemRelPath, SkippedTooLarge)
 				continue
 			}
 		}
 
-		if entry.IsDir() {
+		if opts.WriteMetadata {
+			u.metadata = append(u.metadata, FileMetadata{Path: itemRelPath, Mode: info.Mode(), ModTime: info.ModTime()})
+		}
+
+		if info.IsDir() {
 			// Get or create folder
 			folderName := entry.Name()
-			folderID, err := c.GetOrCreateFolder(ctx, folderName, parentID)
+			folderID, folderCreated, err := u.resolveFolder(ctx, folderName, parentID, remote)
 			if err != nil {
 				return err
 			}
 			// log.Printf("GML: folder: %v (ID: %v)\n", folderName, *folderID)
+			u.mu.Lock()
+			stats.IDs[itemRelPath] = *folderID
+			u.mu.Unlock()
 			// Recursively upload contents of this folder
-			if err := c.UploadDirectory(ctx, itemPath, folderID, excludePatterns); err != nil {
+			if err := u.upload(ctx, itemPath, itemRelPath, folderID, folderCreated); err != nil {
 				return err
 			}
 		} else {
-			// Upload file
-			if err := c.UploadFileFromPath(ctx, itemPath, parentID, true); err != nil {
-				return err
+			// Upload file, unless an identical copy is already there
+			if opts.SkipUnchanged && !opts.ForceOverwrite && unchangedRemotely(info, remote, parentID) {
+				u.skip(itemRelPath, SkippedUnchanged)
+				continue
 			}
-			// Add a small delay to avoid overwhelming the API
-			time.Sleep(500 * time.Millisecond)
+			replaced := replacedFiles(remote, entry.Name(), parentID)
+			u.g.Go(func() error { return u.uploadFile(ctx, itemPath, itemRelPath, parentID, replaced) })
+		}
+	}
+
+	return nil
+}
+
+// resolveFolder returns the ID of the folder name within parentID, whose
+// entries are remote, creating it if needed, and whether it was created.
+// Unlike GetOrCreateFolder, it does not need to look the folder up.
+func (u *dirUploader) resolveFolder(ctx context.Context, name string, parentID *int64, remote []Entry) (*int64, bool, error) {
+	c := u.c
+	folders := &c.doer().folders
+	for _, e := range remote {
+		if e.IsFolder() && c.doer().nameMatcher(name, e.Name) {
+			folders.put(name, parentID, cachedFolder{id: e.ID, path: e.Path})
+			return &e.ID, false, nil
+		}
+	}
+
+	unlock := folders.lock(name, parentID)
+	defer unlock()
+	if f, ok := folders.get(name, parentID); ok {
+		return &f.id, false, nil // created since remote was listed
+	}
+	folder, _, err := c.createFolder(ctx, name, parentID)
+	if err != nil {
+		return nil, false, err
+	}
+	return &folder.ID, true, nil
+}
+
+// replacedFiles returns the IDs of the files in remote, the entries of the
+// folder parentID, that an upload of name with overwrite would replace.
+func replacedFiles(remote []Entry, name string, parentID *int64) []int64 {
+	var ids []int64
+	for _, e := range remote {
+		if isOverwriteTarget(e, name, parentID) {
+			ids = append(ids, e.ID)
 		}
 	}
+	return ids
+}
 
+// unchangedRemotely reports whether remote, the entries of the destination
+// folder parentID, already holds an up-to-date copy of the local file
+// described by info. It matches names exactly, as the overwrite does.
+func unchangedRemotely(info fs.FileInfo, remote []Entry, parentID *int64) bool {
+	for _, e := range remote {
+		if isOverwriteTarget(e, info.Name(), parentID) &&
+			e.Size == info.Size() && !e.UpdatedAt.Before(info.ModTime()) {
+			return true
+		}
+	}
+	return false
+}
+
+// uploadFile uploads the local file itemPath, whose path relative to the
+// top-level directory is relPath, into the folder parentID, replacing the
+// files replaced, as found in the folder's listing. It is run by the worker pool.
+func (u *dirUploader) uploadFile(ctx context.Context, itemPath, relPath string, parentID *int64, replaced []int64) error {
+	c := u.c
+	result, err := c.uploadFileFromPath(ctx, u.fsys, itemPath, "", u.opts.mimeType(itemPath), parentID, len(replaced) > 0, replaced)
+	if fn := u.opts.OnFileComplete; fn != nil {
+		fn(relPath, err)
+	}
+	if err != nil && u.opts.ContinueOnError {
+		u.mu.Lock()
+		u.stats.Failed = append(u.stats.Failed, FailedUpload{LocalPath: itemPath, ParentID: parentID, Err: err.Error()})
+		u.errs = append(u.errs, err)
+		u.mu.Unlock()
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	u.mu.Lock()
+	u.stats.IDs[relPath] = result.ID
+	u.mu.Unlock()
+	return c.pauseAfterUpload(ctx)
+}
+
+// pauseAfterUpload adds a small delay after each file uploaded as part of a
+// tree to avoid overwhelming the API, unless a rate limiter already does so.
+func (c *Client) pauseAfterUpload(ctx context.Context) error {
+	if d := c.doer(); d.limiter == nil {
+		return sleepContext(ctx, d.uploadDelay)
+	}
 	return nil
 }
+
+// readDir lists the directory dir of the tree being uploaded.
+func (u *dirUploader) readDir(dir string) ([]fs.DirEntry, error) {
+	if u.fsys == nil {
+		return os.ReadDir(dir)
+	}
+	return fs.ReadDir(u.fsys, dir)
+}
+
+// join returns the path of the entry name in the directory dir of the tree
+// being uploaded.
+func (u *dirUploader) join(dir, name string) string {
+	if u.fsys == nil {
+		return filepath.Join(dir, name)
+	}
+	return path.Join(dir, name)
+}
+
+// skip records that relPath was skipped for the given reason.
+func (u *dirUploader) skip(relPath string, reason SkipReason) {
+	u.mu.Lock()
+	u.stats.skip(relPath, reason)
+	u.mu.Unlock()
+}
@@ -0,0 +1,1727 @@
+package folderfort
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"maps"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+	"golang.org/x/oauth2"
+	"golang.org/x/time/rate"
+)
+
+// recordedRequest is a request captured by fakeTransport.
+type recordedRequest struct {
+	Method string
+	Path   string // below /api/v1, such as "/folders"
+	Query  url.Values
+	Header http.Header
+	Body   []byte
+
+	ContentLength int64
+}
+
+// fakeHandler returns the status and body of the response to req.
+type fakeHandler func(req recordedRequest) (status int, body string)
+
+// fakeTransport is an HttpRequestDoer and http.RoundTripper that records
+// every request and answers it with a canned response from handler.
+type fakeTransport struct {
+	handler fakeHandler
+
+	mu       sync.Mutex
+	requests []recordedRequest
+}
+
+var _ HttpRequestDoer = &fakeTransport{}
+
+func (f *fakeTransport) Do(req *http.Request) (*http.Response, error) {
+	rec := recordedRequest{
+		Method: req.Method,
+		Path:   strings.TrimPrefix(req.URL.Path, "/api/v1"),
+		Query:  req.URL.Query(),
+		Header: req.Header.Clone(),
+
+		ContentLength: req.ContentLength,
+	}
+	if req.Body != nil {
+		body, err := io.ReadAll(req.Body)
+		if err != nil {
+			return nil, err
+		}
+		req.Body.Close()
+		rec.Body = body
+	}
+
+	f.mu.Lock()
+	f.requests = append(f.requests, rec)
+	f.mu.Unlock()
+
+	status, body := f.handler(rec)
+	return &http.Response{
+		StatusCode: status,
+		Status:     http.StatusText(status),
+		Header:     http.Header{"Content-Type": {"application/json"}},
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Request:    req,
+	}, nil
+}
+
+func (f *fakeTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f.Do(req)
+}
+
+// requestsTo returns the recorded requests with the given method and path.
+func (f *fakeTransport) requestsTo(method, path string) []recordedRequest {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var reqs []recordedRequest
+	for _, r := range f.requests {
+		if r.Method == method && r.Path == path {
+			reqs = append(reqs, r)
+		}
+	}
+	return reqs
+}
+
+// paths returns "METHOD /path" for every recorded request, in order.
+func (f *fakeTransport) paths() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var paths []string
+	for _, r := range f.requests {
+		paths = append(paths, r.Method+" "+r.Path)
+	}
+	return paths
+}
+
+// newFakeClient returns a client whose requests are all answered by handler.
+func newFakeClient(t *testing.T, handler fakeHandler, opts ...ClientOption) (*Client, *fakeTransport) {
+	t.Helper()
+	ft := &fakeTransport{handler: handler}
+	opts = append([]ClientOption{WithTransport(ft), WithRetry(0, 0)}, opts...)
+	c, err := NewClientWithAPIToken("https://example.com/api/v1", "token", false, opts...)
+	if err != nil {
+		t.Fatalf("NewClientWithAPIToken: %v", err)
+	}
+	return c, ft
+}
+
+// indexPage returns an IndexEntry response body holding entries.
+func indexPage(t *testing.T, page, lastPage int64, entries ...map[string]any) string {
+	t.Helper()
+	if entries == nil {
+		entries = []map[string]any{}
+	}
+	buf, err := json.Marshal(map[string]any{
+		"current_page": page,
+		"last_page":    lastPage,
+		"total":        len(entries),
+		"data":         entries,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(buf)
+}
+
+func folderEntry(id int64, name string, parentID *int64) map[string]any {
+	return map[string]any{"id": id, "name": name, "type": "folder", "parent_id": parentID}
+}
+
+func TestGetOrCreateFolder_Existing(t *testing.T) {
+	c, ft := newFakeClient(t, func(req recordedRequest) (int, string) {
+		if req.Path == "/drive/file-entries" {
+			return 200, indexPage(t, 1, 1, folderEntry(7, "docs", nil))
+		}
+		return 500, `{"message":"unexpected request"}`
+	})
+
+	id, err := c.GetOrCreateFolder(context.Background(), "docs", nil)
+	if err != nil {
+		t.Fatalf("GetOrCreateFolder: %v", err)
+	}
+	if *id != 7 {
+		t.Errorf("id = %v, want 7", *id)
+	}
+	if got := ft.requestsTo("POST", "/folders"); len(got) != 0 {
+		t.Errorf("created %v folders, want none", len(got))
+	}
+}
+
+func TestGetOrCreateFolder_CreatesPathOnce(t *testing.T) {
+	var nextID int64 = 100
+	c, ft := newFakeClient(t, func(req recordedRequest) (int, string) {
+		switch req.Path {
+		case "/drive/file-entries":
+			return 200, indexPage(t, 1, 1)
+		case "/folders":
+			nextID++
+			return 200, `{"status":"success","folder":{"id":` + jsonInt(nextID) + `}}`
+		}
+		return 500, `{"message":"unexpected request"}`
+	})
+
+	ctx := context.Background()
+	for range 2 {
+		id, err := c.GetOrCreateFolder(ctx, "a/b", nil)
+		if err != nil {
+			t.Fatalf("GetOrCreateFolder: %v", err)
+		}
+		if *id != 102 {
+			t.Errorf("id = %v, want 102", *id)
+		}
+	}
+
+	creates := ft.requestsTo("POST", "/folders")
+	if len(creates) != 2 {
+		t.Fatalf("created %v folders, want 2 (the second call should use the cache)", len(creates))
+	}
+	var body struct {
+		Name     string `json:"name"`
+		ParentID *int64 `json:"parentId"`
+	}
+	if err := json.Unmarshal(creates[1].Body, &body); err != nil {
+		t.Fatal(err)
+	}
+	if body.Name != "b" || body.ParentID == nil || *body.ParentID != 101 {
+		t.Errorf("second folder created as %q in %v, want \"b\" in 101", body.Name, ptrValue(body.ParentID))
+	}
+}
+
+func TestGetOrCreateFolderEntry_Path(t *testing.T) {
+	c, ft := newFakeClient(t, func(req recordedRequest) (int, string) {
+		switch req.Path {
+		case "/drive/file-entries":
+			docs := folderEntry(7, "docs", nil)
+			docs["path"] = "7"
+			return 200, indexPage(t, 1, 1, docs)
+		case "/folders":
+			return 200, `{"status":"success","folder":{"id":8,"name":"new","parent_id":7,"path":"7/8"}}`
+		}
+		return 500, `{"message":"unexpected request"}`
+	})
+
+	ctx := context.Background()
+	for range 2 {
+		folder, err := c.GetOrCreateFolderEntry(ctx, "docs", nil)
+		if err != nil {
+			t.Fatalf("GetOrCreateFolderEntry: %v", err)
+		}
+		if folder.ID != 7 || folder.Path != "7" {
+			t.Errorf("existing folder = %+v, want ID 7 and path 7", folder)
+		}
+	}
+	if got := ft.requestsTo("GET", "/drive/file-entries"); len(got) != 1 {
+		t.Errorf("looked up folder %v times, want once (the second call should use the cache)", len(got))
+	}
+
+	refs, err := c.GetOrCreateFolderPath(ctx, "docs/new", nil)
+	if err != nil {
+		t.Fatalf("GetOrCreateFolderPath: %v", err)
+	}
+	want := []FolderRef{{Name: "docs", ID: 7, Path: "7"}, {Name: "new", ID: 8, Path: "7/8"}}
+	if !slices.Equal(refs, want) {
+		t.Errorf("refs = %+v, want %+v", refs, want)
+	}
+}
+
+func TestGetOrCreateFolder_MatchesCaseInsensitively(t *testing.T) {
+	c, ft := newFakeClient(t, func(req recordedRequest) (int, string) {
+		if req.Path == "/drive/file-entries" {
+			return 200, indexPage(t, 1, 1, folderEntry(7, "photos", nil))
+		}
+		return 500, `{"message":"unexpected request"}`
+	})
+
+	id, err := c.GetOrCreateFolder(context.Background(), "Photos", nil)
+	if err != nil {
+		t.Fatalf("GetOrCreateFolder: %v", err)
+	}
+	if *id != 7 {
+		t.Errorf("id = %v, want 7", *id)
+	}
+	if got := ft.requestsTo("POST", "/folders"); len(got) != 0 {
+		t.Errorf("created a duplicate folder")
+	}
+}
+
+func TestGetEntriesByName_Pagination(t *testing.T) {
+	c, ft := newFakeClient(t, func(req recordedRequest) (int, string) {
+		switch req.Query.Get("page") {
+		case "1":
+			return 200, indexPage(t, 1, 2, folderEntry(1, "other", Ptr(int64(5))))
+		case "2":
+			return 200, indexPage(t, 2, 2, folderEntry(42, "target", Ptr(int64(5))))
+		}
+		return 500, `{"message":"unexpected page"}`
+	})
+
+	ids, err := c.getEntriesByName(context.Background(), "target", Ptr(int64(5)), nil)
+	if err != nil {
+		t.Fatalf("getEntriesByName: %v", err)
+	}
+	if !slices.Equal(ids, []int64{42}) {
+		t.Errorf("ids = %v, want [42]", ids)
+	}
+
+	reqs := ft.requestsTo("GET", "/drive/file-entries")
+	if len(reqs) != 2 {
+		t.Fatalf("made %v index requests, want 2", len(reqs))
+	}
+	if got := reqs[0].Query.Get("parentIds"); got != "5" {
+		t.Errorf("parentIds = %q, want \"5\"", got)
+	}
+	if got := reqs[0].Query.Get("query"); got != "target" {
+		t.Errorf("query = %q, want \"target\"", got)
+	}
+}
+
+func TestGetEntriesByName_IgnoresOtherParents(t *testing.T) {
+	c, _ := newFakeClient(t, func(req recordedRequest) (int, string) {
+		return 200, indexPage(t, 1, 1,
+			folderEntry(1, "target", Ptr(int64(6))),
+			folderEntry(2, "target", Ptr(int64(5))))
+	})
+
+	ids, err := c.getEntriesByName(context.Background(), "target", Ptr(int64(5)), nil)
+	if err != nil {
+		t.Fatalf("getEntriesByName: %v", err)
+	}
+	if !slices.Equal(ids, []int64{2}) {
+		t.Errorf("ids = %v, want [2]", ids)
+	}
+}
+
+func TestGetEntriesByName_InvalidType(t *testing.T) {
+	c, ft := newFakeClient(t, func(req recordedRequest) (int, string) {
+		return 500, `{"message":"unexpected request"}`
+	})
+
+	if _, err := c.getEntriesByName(context.Background(), "x", nil, Ptr(IndexEntryParamsType("bogus"))); err == nil {
+		t.Error("getEntriesByName with an invalid type succeeded")
+	}
+	if len(ft.paths()) != 0 {
+		t.Errorf("made requests %v, want none", ft.paths())
+	}
+}
+
+func TestDeleteEntries(t *testing.T) {
+	tests := []struct {
+		name    string
+		forever bool
+		want    string
+	}{
+		{name: "trash", forever: false, want: "false"},
+		{name: "forever", forever: true, want: "true"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, ft := newFakeClient(t, func(req recordedRequest) (int, string) {
+				return 200, `{"status":"success"}`
+			})
+
+			var err error
+			if tt.forever {
+				err = c.DeleteEntriesForeverByID(context.Background(), []int64{1, 2})
+			} else {
+				err = c.DeleteEntriesByID(context.Background(), []int64{1, 2})
+			}
+			if err != nil {
+				t.Fatalf("delete: %v", err)
+			}
+
+			reqs := ft.requestsTo("POST", "/file-entries")
+			if len(reqs) != 1 {
+				t.Fatalf("requests = %v, want one POST /file-entries", ft.paths())
+			}
+			if got := reqs[0].Header.Get("X-HTTP-Method-Override"); got != "DELETE" {
+				t.Errorf("X-HTTP-Method-Override = %q, want DELETE", got)
+			}
+			var body struct {
+				EntryIDs      []string `json:"entryIds"`
+				DeleteForever string   `json:"deleteForever"`
+			}
+			if err := json.Unmarshal(reqs[0].Body, &body); err != nil {
+				t.Fatal(err)
+			}
+			if !slices.Equal(body.EntryIDs, []string{"1", "2"}) || body.DeleteForever != tt.want {
+				t.Errorf("body = %s, want entryIds [1 2] and deleteForever %q", reqs[0].Body, tt.want)
+			}
+		})
+	}
+}
+
+func TestDeleteEntries_APIError(t *testing.T) {
+	c, _ := newFakeClient(t, func(req recordedRequest) (int, string) {
+		return 422, `{"message":"The selected entry ids is invalid."}`
+	})
+
+	err := c.DeleteEntries(context.Background(), []string{"1"})
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("err = %v, want an *APIError", err)
+	}
+	if apiErr.StatusCode != 422 || apiErr.Message != "The selected entry ids is invalid." {
+		t.Errorf("err = %+v", apiErr)
+	}
+}
+
+// uploadHandler answers uploads with the entry ID 100 and the upload config
+// with no size limit, delegating every other request to other.
+func uploadHandler(other fakeHandler) fakeHandler {
+	return func(req recordedRequest) (int, string) {
+		switch req.Path {
+		case "/uploads":
+			return 201, `{"status":"success","fileEntry":{"id":100,"name":"x.txt","file_size":5}}`
+		case "/uploads/config":
+			return 200, `{"max_size":0}`
+		}
+		return other(req)
+	}
+}
+
+func TestRequestEditorAndResponseInspector(t *testing.T) {
+	var statuses []int
+	c, ft := newFakeClient(t, func(req recordedRequest) (int, string) {
+		return 200, indexPage(t, 1, 1, folderEntry(7, "docs", nil))
+	},
+		WithRequestEditor(func(req *http.Request) error {
+			req.Header.Set("X-Request-ID", "abc")
+			req.Header.Set("Authorization", "Bearer stolen")
+			return nil
+		}),
+		WithResponseInspector(func(resp *http.Response) error {
+			statuses = append(statuses, resp.StatusCode)
+			return nil
+		}))
+
+	if _, err := c.GetOrCreateFolder(context.Background(), "docs", nil); err != nil {
+		t.Fatalf("GetOrCreateFolder: %v", err)
+	}
+	reqs := ft.requestsTo("GET", "/drive/file-entries")
+	if len(reqs) != 1 {
+		t.Fatalf("requests = %v, want one index request", ft.paths())
+	}
+	if got := reqs[0].Header.Get("X-Request-ID"); got != "abc" {
+		t.Errorf("X-Request-ID = %q, want abc", got)
+	}
+	if got := reqs[0].Header.Get("Authorization"); got != "Bearer token" {
+		t.Errorf("Authorization = %q, want the API token", got)
+	}
+	if !slices.Equal(statuses, []int{200}) {
+		t.Errorf("inspected statuses = %v, want [200]", statuses)
+	}
+
+	errBlocked := errors.New("blocked")
+	c, ft = newFakeClient(t, func(req recordedRequest) (int, string) {
+		return 200, indexPage(t, 1, 1)
+	}, WithRequestEditor(func(req *http.Request) error { return errBlocked }))
+	if _, err := c.GetOrCreateFolder(context.Background(), "docs", nil); !errors.Is(err, errBlocked) {
+		t.Errorf("GetOrCreateFolder error = %v, want %v", err, errBlocked)
+	}
+	if len(ft.paths()) != 0 {
+		t.Errorf("requests = %v, want none", ft.paths())
+	}
+}
+
+// recordingTracer is a no-op tracer that records the names of its spans.
+type recordingTracer struct {
+	noop.Tracer
+	names []string
+}
+
+func (r *recordingTracer) Start(ctx context.Context, name string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	r.names = append(r.names, name)
+	return r.Tracer.Start(ctx, name, opts...)
+}
+
+type recordingTracerProvider struct {
+	noop.TracerProvider
+	tracer *recordingTracer
+}
+
+func (p recordingTracerProvider) Tracer(string, ...trace.TracerOption) trace.Tracer {
+	return p.tracer
+}
+
+func TestWithTracerProvider(t *testing.T) {
+	tracer := &recordingTracer{}
+	c, _ := newFakeClient(t, func(req recordedRequest) (int, string) {
+		return 200, `{"fileEntry":{"id":42,"name":"a.txt"}}`
+	}, WithTracerProvider(recordingTracerProvider{tracer: tracer}))
+
+	if _, err := c.GetEntry(context.Background(), 42); err != nil {
+		t.Fatalf("GetEntry: %v", err)
+	}
+	if want := []string{"GET /file-entries/{id}"}; !slices.Equal(tracer.names, want) {
+		t.Errorf("span names = %q, want %q", tracer.names, want)
+	}
+}
+
+func TestUploadFile_MultipartBody(t *testing.T) {
+	c, ft := newFakeClient(t, uploadHandler(func(req recordedRequest) (int, string) {
+		return 500, `{"message":"unexpected request"}`
+	}))
+
+	result, err := c.UploadFileWithResult(context.Background(), "x.txt", strings.NewReader("hello"), "text/plain", Ptr(int64(9)), false)
+	if err != nil {
+		t.Fatalf("UploadFileWithResult: %v", err)
+	}
+	if result.ID != 100 || result.Name != "x.txt" || result.Size != 5 {
+		t.Errorf("result = %+v", result)
+	}
+
+	reqs := ft.requestsTo("POST", "/uploads")
+	if len(reqs) != 1 {
+		t.Fatalf("requests = %v, want one POST /uploads", ft.paths())
+	}
+	mediaType, params, err := mime.ParseMediaType(reqs[0].Header.Get("Content-Type"))
+	if err != nil || mediaType != "multipart/form-data" {
+		t.Fatalf("Content-Type = %q, want multipart/form-data", reqs[0].Header.Get("Content-Type"))
+	}
+
+	mr := multipart.NewReader(bytes.NewReader(reqs[0].Body), params["boundary"])
+	got := map[string]string{}
+	for {
+		part, err := mr.NextPart()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("NextPart: %v", err)
+		}
+		content, _ := io.ReadAll(part)
+		got[part.FormName()] = string(content)
+		if part.FormName() == "file" {
+			if part.FileName() != "x.txt" {
+				t.Errorf("file name = %q, want x.txt", part.FileName())
+			}
+			if ct := part.Header.Get("Content-Type"); ct != "text/plain" {
+				t.Errorf("file Content-Type = %q, want text/plain", ct)
+			}
+		}
+	}
+	if got["parentId"] != "9" || got["file"] != "hello" {
+		t.Errorf("multipart fields = %q, want parentId 9 and file hello", got)
+	}
+}
+
+func TestUploadFile_SniffsMissingMIMEType(t *testing.T) {
+	c, ft := newFakeClient(t, uploadHandler(func(req recordedRequest) (int, string) {
+		return 500, `{"message":"unexpected request"}`
+	}))
+
+	png := "\x89PNG\r\n\x1a\n rest of image"
+	if err := c.UploadFile(context.Background(), "image", strings.NewReader(png), "", nil, false); err != nil {
+		t.Fatalf("UploadFile: %v", err)
+	}
+
+	reqs := ft.requestsTo("POST", "/uploads")
+	if len(reqs) != 1 {
+		t.Fatalf("requests = %v, want one POST /uploads", ft.paths())
+	}
+	if !bytes.Contains(reqs[0].Body, []byte("Content-Type: image/png")) {
+		t.Errorf("upload body does not declare image/png:\n%s", reqs[0].Body)
+	}
+	if !bytes.Contains(reqs[0].Body, []byte(png)) {
+		t.Error("upload body lost the sniffed bytes")
+	}
+}
+
+func TestUploadReaderSized(t *testing.T) {
+	c, ft := newFakeClient(t, uploadHandler(func(req recordedRequest) (int, string) {
+		return 500, `{"message":"unexpected request"}`
+	}))
+
+	ctx := context.Background()
+	if _, err := c.UploadReaderSized(ctx, "x.txt", strings.NewReader("hello, world"), 5, "text/plain", nil, false); err != nil {
+		t.Fatalf("UploadReaderSized: %v", err)
+	}
+	reqs := ft.requestsTo("POST", "/uploads")
+	if len(reqs) != 1 {
+		t.Fatalf("requests = %v, want one POST /uploads", ft.paths())
+	}
+	if got, want := reqs[0].ContentLength, int64(len(reqs[0].Body)); got != want {
+		t.Errorf("ContentLength = %v, want %v", got, want)
+	}
+	if !bytes.Contains(reqs[0].Body, []byte("\r\n\r\nhello\r\n--")) {
+		t.Errorf("body does not hold exactly the first 5 bytes:\n%s", reqs[0].Body)
+	}
+
+	if _, err := c.UploadReaderSized(ctx, "x.txt", strings.NewReader("hi"), 5, "text/plain", nil, false); err == nil {
+		t.Error("UploadReaderSized with short content succeeded")
+	}
+}
+
+func TestUploadFileWithResponse(t *testing.T) {
+	c, _ := newFakeClient(t, uploadHandler(func(req recordedRequest) (int, string) {
+		return 500, `{"message":"unexpected request"}`
+	}))
+
+	result, resp, err := c.UploadFileWithResponse(context.Background(), "x.txt", strings.NewReader("hello"), "text/plain", nil, false)
+	if err != nil {
+		t.Fatalf("UploadFileWithResponse: %v", err)
+	}
+	if result.ID != 100 {
+		t.Errorf("result = %+v", result)
+	}
+	if resp == nil || resp.StatusCode != 201 || resp.Header.Get("Content-Type") != "application/json" {
+		t.Fatalf("resp = %+v, want the 201 upload response", resp)
+	}
+	if body, _ := io.ReadAll(resp.Body); !bytes.Contains(body, []byte(`"id":100`)) {
+		t.Errorf("resp.Body = %q, want the upload response", body)
+	}
+}
+
+func TestUploadFile_Compression(t *testing.T) {
+	c, ft := newFakeClient(t, uploadHandler(func(req recordedRequest) (int, string) {
+		return 500, `{"message":"unexpected request"}`
+	}), WithUploadCompression(true))
+
+	ctx := context.Background()
+	if err := c.UploadFile(ctx, "x.txt", strings.NewReader("hello"), "text/plain", nil, false); err != nil {
+		t.Fatalf("UploadFile(text): %v", err)
+	}
+	if err := c.UploadFile(ctx, "x.zip", strings.NewReader("hello"), "application/zip", nil, false); err != nil {
+		t.Fatalf("UploadFile(zip): %v", err)
+	}
+
+	reqs := ft.requestsTo("POST", "/uploads")
+	if len(reqs) != 2 {
+		t.Fatalf("requests = %v, want two POST /uploads", ft.paths())
+	}
+	for i, wantGzip := range []bool{true, false} {
+		_, params, _ := mime.ParseMediaType(reqs[i].Header.Get("Content-Type"))
+		mr := multipart.NewReader(bytes.NewReader(reqs[i].Body), params["boundary"])
+		for {
+			part, err := mr.NextPart()
+			if err != nil {
+				t.Fatalf("request %v has no file part: %v", i, err)
+			}
+			if part.FormName() != "file" {
+				continue
+			}
+			var r io.Reader = part
+			if gotGzip := part.Header.Get("Content-Encoding") == "gzip"; gotGzip != wantGzip {
+				t.Errorf("request %v gzipped = %v, want %v", i, gotGzip, wantGzip)
+			} else if gotGzip {
+				if r, err = gzip.NewReader(part); err != nil {
+					t.Fatalf("gzip.NewReader: %v", err)
+				}
+			}
+			if content, _ := io.ReadAll(r); string(content) != "hello" {
+				t.Errorf("request %v content = %q, want hello", i, content)
+			}
+			break
+		}
+	}
+}
+
+func TestUploadFile_Overwrite(t *testing.T) {
+	tests := []struct {
+		name    string
+		atomic  bool
+		wantSeq []string
+	}{
+		{
+			name:    "delete first",
+			wantSeq: []string{"GET /drive/file-entries", "POST /file-entries", "POST /uploads"},
+		},
+		{
+			name:    "atomic replace",
+			atomic:  true,
+			wantSeq: []string{"GET /drive/file-entries", "POST /uploads", "POST /file-entries"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, ft := newFakeClient(t, uploadHandler(func(req recordedRequest) (int, string) {
+				switch req.Path {
+				case "/drive/file-entries":
+					return 200, indexPage(t, 1, 1, map[string]any{"id": 55, "name": "x.txt", "type": "text", "parent_id": 9})
+				case "/file-entries":
+					return 200, `{"status":"success"}`
+				}
+				return 500, `{"message":"unexpected request"}`
+			}), WithAtomicReplace(tt.atomic), WithMaxFileSize(-1))
+
+			if err := c.UploadFile(context.Background(), "x.txt", strings.NewReader("hello"), "text/plain", Ptr(int64(9)), true); err != nil {
+				t.Fatalf("UploadFile: %v", err)
+			}
+
+			if got := ft.paths(); !slices.Equal(got, tt.wantSeq) {
+				t.Errorf("requests = %v, want %v", got, tt.wantSeq)
+			}
+			deletes := ft.requestsTo("POST", "/file-entries")
+			if len(deletes) != 1 || !bytes.Contains(deletes[0].Body, []byte(`"entryIds":["55"]`)) {
+				t.Errorf("delete requests = %v, want one deleting entry 55", deletes)
+			}
+		})
+	}
+}
+
+func TestUploadFile_NoParentsWithoutAutoCreate(t *testing.T) {
+	c, _ := newFakeClient(t, func(req recordedRequest) (int, string) {
+		return 200, indexPage(t, 1, 1)
+	}, WithAutoCreateParents(false))
+
+	err := c.UploadFile(context.Background(), "missing/x.txt", strings.NewReader("hello"), "text/plain", nil, false)
+	if !errors.Is(err, ErrParentNotFound) {
+		t.Errorf("err = %v, want ErrParentNotFound", err)
+	}
+}
+
+func jsonInt(n int64) string {
+	buf, _ := json.Marshal(n)
+	return string(buf)
+}
+
+func TestNewClientWithAPIToken_Region(t *testing.T) {
+	tests := []struct {
+		server  string
+		want    string
+		wantErr bool
+	}{
+		{server: "na", want: "https://na.folderfort.com/api/v1/"},
+		{server: "NA", want: "https://na.folderfort.com/api/v1/"},
+		{server: "https://example.com/api/v1", want: "https://example.com/api/v1/"},
+		{server: "mars", wantErr: true},
+		{server: "eu", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.server, func(t *testing.T) {
+			c, err := NewClientWithAPIToken(tt.server, "token", false)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("NewClientWithAPIToken(%q) succeeded, want error", tt.server)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("NewClientWithAPIToken(%q): %v", tt.server, err)
+			}
+			if c.Server != tt.want {
+				t.Errorf("Server = %q, want %q", c.Server, tt.want)
+			}
+		})
+	}
+}
+
+func TestFolderExists(t *testing.T) {
+	c, _ := newFakeClient(t, func(req recordedRequest) (int, string) {
+		switch req.Query.Get("query") {
+		case "docs":
+			return 200, indexPage(t, 1, 1, folderEntry(7, "docs", nil))
+		case "broken":
+			return 500, `{"message":"boom"}`
+		}
+		return 200, indexPage(t, 1, 1)
+	})
+
+	ctx := context.Background()
+	tests := []struct {
+		name    string
+		want    bool
+		wantID  int64
+		wantErr bool
+	}{
+		{name: "docs", want: true, wantID: 7},
+		{name: "missing"},
+		{name: "missing/docs"},
+		{name: "broken", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ok, id, err := c.FolderExists(ctx, tt.name, nil)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("FolderExists error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if ok != tt.want || (ok && *id != tt.wantID) {
+				t.Errorf("FolderExists = %v, %v; want %v, %v", ok, ptrValue(id), tt.want, tt.wantID)
+			}
+		})
+	}
+}
+
+func TestUploadFS(t *testing.T) {
+	var nextID int64 = 10
+	c, ft := newFakeClient(t, uploadHandler(func(req recordedRequest) (int, string) {
+		switch req.Path {
+		case "/drive/file-entries":
+			return 200, indexPage(t, 1, 1)
+		case "/folders":
+			nextID++
+			return 200, `{"status":"success","folder":{"id":` + jsonInt(nextID) + `}}`
+		}
+		return 500, `{"message":"unexpected request"}`
+	}), WithUploadDelay(0))
+
+	fsys := fstest.MapFS{
+		"site/index.html":        {Data: []byte("<html>")},
+		"site/css/style.css":     {Data: []byte("body{}")},
+		"site/node_modules/x.js": {Data: []byte("x")},
+		"other.txt":              {Data: []byte("other")},
+	}
+	if err := c.UploadFS(context.Background(), fsys, "site", nil, nil); err != nil {
+		t.Fatalf("UploadFS: %v", err)
+	}
+
+	if got := ft.requestsTo("POST", "/folders"); len(got) != 1 {
+		t.Errorf("created %v folders, want only css", len(got))
+	}
+	uploads := ft.requestsTo("POST", "/uploads")
+	if len(uploads) != 2 {
+		t.Fatalf("requests = %v, want two uploads", ft.paths())
+	}
+	var names []string
+	for _, u := range uploads {
+		for _, name := range []string{"index.html", "style.css", "x.js", "other.txt"} {
+			if bytes.Contains(u.Body, []byte(`filename="`+name+`"`)) {
+				names = append(names, name)
+			}
+		}
+	}
+	slices.Sort(names)
+	if want := []string{"index.html", "style.css"}; !slices.Equal(names, want) {
+		t.Errorf("uploaded %q, want %q", names, want)
+	}
+}
+
+func TestUploadFSWithOptions_Stats(t *testing.T) {
+	c, ft := newFakeClient(t, uploadHandler(func(req recordedRequest) (int, string) {
+		switch req.Path {
+		case "/drive/file-entries":
+			return 200, indexPage(t, 1, 1, folderEntry(7, "css", nil))
+		case "/folders":
+			return 500, `{"message":"css should be found in the listing"}`
+		}
+		return 500, `{"message":"unexpected request"}`
+	}), WithUploadDelay(0))
+
+	fsys := fstest.MapFS{
+		"index.html":       {Data: []byte("<html>")},
+		"css/style.css":    {Data: []byte("body{}")},
+		".git/config":      {Data: []byte("x")},
+		"docs/readme.txt":  {Data: []byte("x")},
+		"docs/skip/me.txt": {Data: []byte("x")},
+	}
+	stats, err := c.UploadFSWithOptions(context.Background(), fsys, ".", nil, &UploadOptions{ExcludeNames: []string{"docs"}})
+	if err != nil {
+		t.Fatalf("UploadFSWithOptions: %v", err)
+	}
+
+	// The root folder's listing resolves css without a lookup of its own.
+	for _, r := range ft.requestsTo("GET", "/drive/file-entries") {
+		if q := r.Query.Get("query"); q != "" {
+			t.Errorf("looked up %q, want only folder listings", q)
+		}
+	}
+	want := map[string]int64{"index.html": 100, "css": 7, "css/style.css": 100}
+	if !maps.Equal(stats.IDs, want) {
+		t.Errorf("IDs = %v, want %v", stats.IDs, want)
+	}
+	var skipped []string
+	for _, s := range stats.Skipped {
+		skipped = append(skipped, s.Path)
+	}
+	slices.Sort(skipped)
+	if want := []string{".git", "docs"}; !slices.Equal(skipped, want) {
+		t.Errorf("Skipped = %q, want %q", skipped, want)
+	}
+
+	if _, err := c.UploadFSWithOptions(context.Background(), fsys, ".", nil, &UploadOptions{CheckQuota: true}); err == nil {
+		t.Error("UploadFSWithOptions with CheckQuota succeeded, want an error")
+	}
+}
+
+func TestSetEntryDescription(t *testing.T) {
+	c, ft := newFakeClient(t, func(req recordedRequest) (int, string) {
+		return 200, `{"status":"success","fileEntry":{"id":5}}`
+	})
+
+	ctx := context.Background()
+	if err := c.SetEntryDescription(ctx, 5, "nightly db dump"); err != nil {
+		t.Fatalf("SetEntryDescription: %v", err)
+	}
+	reqs := ft.requestsTo("PUT", "/file-entries/5")
+	if len(reqs) != 1 {
+		t.Fatalf("requests = %v, want one PUT /file-entries/5", ft.paths())
+	}
+	if got := string(reqs[0].Body); !strings.Contains(got, `"description":"nightly db dump"`) {
+		t.Errorf("body = %s, want the description", got)
+	}
+
+	long := strings.Repeat("é", MaxDescriptionLength+1)
+	if err := c.SetEntryDescription(ctx, 5, long); !errors.Is(err, ErrDescriptionTooLong) {
+		t.Errorf("SetEntryDescription(long) error = %v, want ErrDescriptionTooLong", err)
+	}
+	if len(ft.paths()) != 1 {
+		t.Errorf("requests = %v, want no request for the long description", ft.paths())
+	}
+}
+
+func TestUploadFileFromPath_PreserveModTime(t *testing.T) {
+	c, ft := newFakeClient(t, uploadHandler(func(req recordedRequest) (int, string) {
+		return 500, `{"message":"unexpected request"}`
+	}), WithPreserveModTime(true))
+
+	filePath := filepath.Join(t.TempDir(), "x.txt")
+	if err := os.WriteFile(filePath, []byte("hello"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	modTime := time.UnixMilli(1717200000000)
+	if err := os.Chtimes(filePath, modTime, modTime); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := c.UploadFileFromPath(context.Background(), filePath, nil, false); err != nil {
+		t.Fatalf("UploadFileFromPath: %v", err)
+	}
+	reqs := ft.requestsTo("POST", "/uploads")
+	if len(reqs) != 1 {
+		t.Fatalf("requests = %v, want one POST /uploads", ft.paths())
+	}
+	want := "name=\"lastModified\"\r\n\r\n1717200000000\r\n"
+	if !bytes.Contains(reqs[0].Body, []byte(want)) {
+		t.Errorf("upload body does not hold lastModified 1717200000000:\n%s", reqs[0].Body)
+	}
+}
+
+func TestUploadDirectory_ResolvesFromListing(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"top.txt", "a/f.txt", "b/g.txt"} {
+		p := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(p, []byte(name), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	c, ft := newFakeClient(t, uploadHandler(func(req recordedRequest) (int, string) {
+		switch req.Path {
+		case "/drive/file-entries":
+			if req.Query.Get("parentIds") != "" || req.Query.Get("query") != "" && req.Query.Get("query") != "top.txt" {
+				return 200, indexPage(t, 1, 1)
+			}
+			top := map[string]any{"id": 8, "name": "top.txt", "type": "text", "parent_id": nil}
+			return 200, indexPage(t, 1, 1, folderEntry(7, "a", nil), top)
+		case "/folders":
+			return 200, `{"status":"success","folder":{"id":20}}`
+		case "/file-entries":
+			return 200, `{"status":"success"}`
+		}
+		return 500, `{"message":"unexpected request"}`
+	}), WithUploadDelay(0))
+
+	if err := c.UploadDirectory(context.Background(), dir, nil, nil); err != nil {
+		t.Fatalf("UploadDirectory: %v", err)
+	}
+
+	// The root and "a" are listed once each, and "top.txt" is overwritten
+	// using the root's listing rather than another lookup. The new folder
+	// "b" is neither listed nor looked up.
+	var lookups []string
+	for _, r := range ft.requestsTo("GET", "/drive/file-entries") {
+		lookups = append(lookups, r.Query.Get("parentIds")+":"+r.Query.Get("query"))
+	}
+	slices.Sort(lookups)
+	if want := []string{"7:", ":"}; !slices.Equal(lookups, want) {
+		t.Errorf("index requests (parentIds:query) = %q, want %q", lookups, want)
+	}
+	deletes := ft.requestsTo("POST", "/file-entries")
+	if len(deletes) != 1 || !bytes.Contains(deletes[0].Body, []byte(`"entryIds":["8"]`)) {
+		t.Errorf("delete requests = %v, want one replacing top.txt (entry 8)", deletes)
+	}
+	if got := ft.requestsTo("POST", "/folders"); len(got) != 1 {
+		t.Errorf("created %v folders, want only b", len(got))
+	}
+	if got := ft.requestsTo("POST", "/uploads"); len(got) != 3 {
+		t.Errorf("uploaded %v files, want 3", len(got))
+	}
+}
+
+func TestUploadDirectory_SkipUnchangedMatchesNamesExactly(t *testing.T) {
+	dir := t.TempDir()
+	old := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	for _, name := range []string{"a.txt", "b.txt"} {
+		p := filepath.Join(dir, name)
+		if err := os.WriteFile(p, []byte("x"), 0644); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.Chtimes(p, old, old); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	c, ft := newFakeClient(t, uploadHandler(func(req recordedRequest) (int, string) {
+		if req.Path == "/drive/file-entries" {
+			newer := old.Add(time.Hour).Format(time.RFC3339)
+			return 200, indexPage(t, 1, 1,
+				map[string]any{"id": 8, "name": "a.txt", "type": "text", "file_size": 1, "updated_at": newer},
+				map[string]any{"id": 9, "name": "B.TXT", "type": "text", "file_size": 1, "updated_at": newer})
+		}
+		return 500, `{"message":"unexpected request"}`
+	}), WithUploadDelay(0))
+
+	stats, err := c.UploadDirectoryWithOptions(context.Background(), dir, nil, &UploadOptions{SkipUnchanged: true})
+	if err != nil {
+		t.Fatalf("UploadDirectoryWithOptions: %v", err)
+	}
+	if got := ft.requestsTo("POST", "/uploads"); len(got) != 1 || !bytes.Contains(got[0].Body, []byte(`filename="b.txt"`)) {
+		t.Errorf("uploads = %v, want only b.txt (B.TXT is a different file)", len(got))
+	}
+	if len(stats.Skipped) != 1 || stats.Skipped[0].Path != "a.txt" {
+		t.Errorf("Skipped = %+v, want only a.txt", stats.Skipped)
+	}
+}
+
+func TestMoveByPath(t *testing.T) {
+	c, ft := newFakeClient(t, func(req recordedRequest) (int, string) {
+		switch req.Path {
+		case "/drive/file-entries":
+			switch req.Query.Get("query") {
+			case "docs":
+				return 200, indexPage(t, 1, 1, folderEntry(7, "docs", nil))
+			case "a.txt":
+				return 200, indexPage(t, 1, 1, map[string]any{"id": 9, "name": "a.txt", "type": "text", "parent_id": 7})
+			}
+			return 200, indexPage(t, 1, 1)
+		case "/folders":
+			return 200, `{"status":"success","folder":{"id":20}}`
+		case "/file-entries/move":
+			return 200, `{"status":"success"}`
+		}
+		return 500, `{"message":"unexpected request"}`
+	})
+
+	ctx := context.Background()
+	if err := c.MoveByPath(ctx, "docs/a.txt", "archive", nil, nil); err != nil {
+		t.Fatalf("MoveByPath: %v", err)
+	}
+	moves := ft.requestsTo("POST", "/file-entries/move")
+	if len(moves) != 1 {
+		t.Fatalf("requests = %v, want one move", ft.paths())
+	}
+	var body struct {
+		EntryIDs      []int64 `json:"entryIds"`
+		DestinationID int64   `json:"destinationId"`
+	}
+	if err := json.Unmarshal(moves[0].Body, &body); err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(body.EntryIDs, []int64{9}) || body.DestinationID != 20 {
+		t.Errorf("moved %v into %v, want [9] into 20", body.EntryIDs, body.DestinationID)
+	}
+
+	if err := c.MoveByPath(ctx, "docs/missing.txt", "", nil, nil); !errors.Is(err, ErrEntryNotFound) {
+		t.Errorf("MoveByPath(missing) error = %v, want ErrEntryNotFound", err)
+	}
+}
+
+func TestClose(t *testing.T) {
+	c, ft := newFakeClient(t, func(req recordedRequest) (int, string) {
+		return 200, indexPage(t, 1, 1, folderEntry(7, "docs", nil))
+	})
+
+	if err := c.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+	if err := c.Close(); err != nil {
+		t.Fatalf("second Close: %v", err)
+	}
+	if _, err := c.GetOrCreateFolder(context.Background(), "docs", nil); !errors.Is(err, ErrClientClosed) {
+		t.Errorf("GetOrCreateFolder after Close error = %v, want ErrClientClosed", err)
+	}
+	if len(ft.paths()) != 0 {
+		t.Errorf("requests = %v, want none after Close", ft.paths())
+	}
+}
+
+// countingTokenSource returns a new access token on every call.
+type countingTokenSource struct {
+	mu    sync.Mutex
+	calls int
+}
+
+func (s *countingTokenSource) Token() (*oauth2.Token, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.calls++
+	return &oauth2.Token{AccessToken: "tok" + jsonInt(int64(s.calls)), TokenType: "Bearer"}, nil
+}
+
+func TestNewClientWithTokenSource(t *testing.T) {
+	ft := &fakeTransport{handler: func(req recordedRequest) (int, string) {
+		return 200, indexPage(t, 1, 1, folderEntry(7, "docs", nil))
+	}}
+	ts := &countingTokenSource{}
+	c, err := NewClientWithTokenSource("https://example.com/api/v1", ts, false, WithTransport(ft), WithRetry(0, 0))
+	if err != nil {
+		t.Fatalf("NewClientWithTokenSource: %v", err)
+	}
+
+	ctx := context.Background()
+	for _, name := range []string{"docs", "other"} {
+		if _, _, err := c.FolderExists(ctx, name, nil); err != nil {
+			t.Fatalf("FolderExists: %v", err)
+		}
+	}
+	reqs := ft.requestsTo("GET", "/drive/file-entries")
+	if len(reqs) != 2 {
+		t.Fatalf("requests = %v, want two index requests", ft.paths())
+	}
+	for i, want := range []string{"Bearer tok1", "Bearer tok2"} {
+		if got := reqs[i].Header.Get("Authorization"); got != want {
+			t.Errorf("request %v Authorization = %q, want %q", i, got, want)
+		}
+	}
+}
+
+func TestTokenSource_RetriesUnauthorizedOnce(t *testing.T) {
+	tests := []struct {
+		name       string
+		validToken string
+		wantErr    bool
+	}{
+		{name: "refreshed token accepted", validToken: "Bearer tok2"},
+		{name: "refreshed token rejected", validToken: "none", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ft := &fakeTransport{handler: func(req recordedRequest) (int, string) {
+				if req.Header.Get("Authorization") != tt.validToken {
+					return 401, `{"message":"Unauthenticated."}`
+				}
+				return 200, indexPage(t, 1, 1)
+			}}
+			c, err := NewClientWithTokenSource("https://example.com/api/v1", &countingTokenSource{}, false, WithTransport(ft), WithRetry(0, 0))
+			if err != nil {
+				t.Fatalf("NewClientWithTokenSource: %v", err)
+			}
+
+			_, _, err = c.FolderExists(context.Background(), "docs", nil)
+			var apiErr *APIError
+			if tt.wantErr {
+				if !errors.As(err, &apiErr) || apiErr.StatusCode != 401 || apiErr.Message != "Unauthenticated." {
+					t.Errorf("FolderExists error = %v, want the original 401 *APIError", err)
+				}
+			} else if err != nil {
+				t.Errorf("FolderExists: %v", err)
+			}
+			if got := len(ft.paths()); got != 2 {
+				t.Errorf("sent %v requests, want 2", got)
+			}
+		})
+	}
+}
+
+func TestGetUserProfile(t *testing.T) {
+	c, ft := newFakeClient(t, func(req recordedRequest) (int, string) {
+		return 200, `{"status":"success","user":{"id":7,"display_name":"Ada","email":"ada@example.com"},"plan":"Pro","available_space":1000,"max_file_size":10}`
+	})
+	got, err := c.GetUserProfile(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := UserProfile{ID: 7, Name: "Ada", Email: "ada@example.com", Plan: "Pro", StorageLimit: 1000, MaxFileSize: 10}
+	if *got != want {
+		t.Errorf("GetUserProfile = %+v, want %+v", got, want)
+	}
+	if n := len(ft.requestsTo("GET", "/user/me")); n != 1 {
+		t.Errorf("got %v requests to /user/me, want 1", n)
+	}
+}
+
+func TestEntryIsFolderIsFile(t *testing.T) {
+	tests := []struct {
+		typ                  FileEntryType
+		wantFolder, wantFile bool
+	}{
+		{typ: FileEntryTypeFolder, wantFolder: true},
+		{typ: FileEntryTypeImage, wantFile: true},
+		{typ: FileEntryTypeText, wantFile: true},
+		{typ: ""},
+	}
+	for _, tt := range tests {
+		e := &Entry{Type: tt.typ}
+		if got := e.IsFolder(); got != tt.wantFolder {
+			t.Errorf("IsFolder(%q) = %v, want %v", tt.typ, got, tt.wantFolder)
+		}
+		if got := e.IsFile(); got != tt.wantFile {
+			t.Errorf("IsFile(%q) = %v, want %v", tt.typ, got, tt.wantFile)
+		}
+	}
+}
+
+func TestGetOrCreateFolder_MatchParentPath(t *testing.T) {
+	// The server ignores parentIds and reports no parent_id, leaving only
+	// the Path to say which "b" is inside "a".
+	withPath := func(e map[string]any, path string) map[string]any {
+		e["path"] = path
+		return e
+	}
+	c, ft := newFakeClient(t, func(req recordedRequest) (int, string) {
+		if req.Path != "/drive/file-entries" {
+			return 500, `{"message":"unexpected request"}`
+		}
+		switch req.Query.Get("query") {
+		case "a":
+			return 200, indexPage(t, 1, 1, withPath(folderEntry(3, "a", Ptr[int64](1)), "1/3"), withPath(folderEntry(7, "a", nil), "7"))
+		case "b":
+			return 200, indexPage(t, 1, 1, withPath(folderEntry(8, "b", nil), "3/8"), withPath(folderEntry(9, "b", nil), "7/9"))
+		}
+		return 200, indexPage(t, 1, 1)
+	}, WithParentMatch(MatchParentPath))
+
+	id, err := c.GetOrCreateFolder(context.Background(), "a/b", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *id != 9 {
+		t.Errorf("GetOrCreateFolder = %v, want 9", *id)
+	}
+	if n := len(ft.requestsTo("POST", "/folders")); n != 0 {
+		t.Errorf("got %v folder creations, want 0", n)
+	}
+}
+
+func TestDeleteEntries_Batches(t *testing.T) {
+	c, ft := newFakeClient(t, func(req recordedRequest) (int, string) {
+		if bytes.Contains(req.Body, []byte(`"3"`)) {
+			return 422, `{"message":"The selected entry ids is invalid."}`
+		}
+		return 200, `{"status":"success"}`
+	}, WithDeleteBatchSize(2))
+
+	err := c.DeleteEntries(context.Background(), []string{"1", "2", "3", "4", "5"})
+	var delErr *DeleteError
+	if !errors.As(err, &delErr) {
+		t.Fatalf("err = %v, want a *DeleteError", err)
+	}
+	if got, want := delErr.FailedIDs(), []string{"3", "4"}; !slices.Equal(got, want) {
+		t.Errorf("FailedIDs = %v, want %v", got, want)
+	}
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != 422 {
+		t.Errorf("err = %v, want it to wrap the 422 *APIError", err)
+	}
+	if n := len(ft.requestsTo("POST", "/file-entries")); n != 3 {
+		t.Errorf("got %v delete requests, want 3", n)
+	}
+}
+
+func TestDeleteEntriesByID(t *testing.T) {
+	c, ft := newFakeClient(t, func(req recordedRequest) (int, string) {
+		return 200, `{"status":"success"}`
+	})
+
+	if err := c.DeleteEntriesByID(context.Background(), []int64{1, 2}); err != nil {
+		t.Fatal(err)
+	}
+	reqs := ft.requestsTo("POST", "/file-entries")
+	if len(reqs) != 1 {
+		t.Fatalf("requests = %v, want one POST /file-entries", ft.paths())
+	}
+	if got, want := string(reqs[0].Body), `"entryIds":["1","2"]`; !strings.Contains(got, want) {
+		t.Errorf("body = %s, want it to contain %s", got, want)
+	}
+}
+
+func TestFolderCreationDelay_SkippedWithRateLimit(t *testing.T) {
+	c, _ := newFakeClient(t, func(req recordedRequest) (int, string) {
+		if req.Path == "/folders" {
+			return 200, `{"status":"success","folder":{"id":8,"name":"new"}}`
+		}
+		return 200, indexPage(t, 1, 1)
+	}, WithFolderCreationDelay(time.Hour), WithRateLimit(1000, 10))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if _, err := c.GetOrCreateFolder(ctx, "new", nil); err != nil {
+		t.Fatalf("GetOrCreateFolder: %v (the folder delay should be skipped)", err)
+	}
+}
+
+func TestDownloadFileToPath_LocalLarger(t *testing.T) {
+	c, ft := newFakeClient(t, func(req recordedRequest) (int, string) {
+		switch req.Path {
+		case "/file-entries/5/download":
+			if req.Header.Get("Range") != "" {
+				return 416, ``
+			}
+			return 200, `new`
+		case "/file-entries/5":
+			return 200, `{"fileEntry":{"id":5,"name":"a.txt","file_size":3}}`
+		}
+		return 500, `{"message":"unexpected request"}`
+	})
+
+	dest := filepath.Join(t.TempDir(), "a.txt")
+	if err := os.WriteFile(dest, []byte("stale and longer"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.DownloadFileToPath(context.Background(), 5, dest); err != nil {
+		t.Fatalf("DownloadFileToPath: %v", err)
+	}
+	if got, _ := os.ReadFile(dest); string(got) != "new" {
+		t.Errorf("file = %q, want it downloaded again as %q (requests %v)", got, "new", ft.paths())
+	}
+}
+
+func TestDownloadToPath_FailureKeepsExisting(t *testing.T) {
+	c, _ := newFakeClient(t, func(req recordedRequest) (int, string) {
+		return 500, `{"message":"boom"}`
+	}, WithRetry(0, 0))
+
+	dir := t.TempDir()
+	dest := filepath.Join(dir, "a.txt")
+	if err := os.WriteFile(dest, []byte("old"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.DownloadToPath(context.Background(), 5, dest); err == nil {
+		t.Fatal("DownloadToPath succeeded, want an error")
+	}
+	if got, _ := os.ReadFile(dest); string(got) != "old" {
+		t.Errorf("file = %q, want the existing %q kept", got, "old")
+	}
+	if files, _ := os.ReadDir(dir); len(files) != 1 {
+		t.Errorf("directory holds %v files, want only a.txt", len(files))
+	}
+}
+
+func TestBackupAll_UnsafeName(t *testing.T) {
+	for _, name := range []string{"..", "../evil.txt", `..\evil.txt`} {
+		c, ft := newFakeClient(t, func(req recordedRequest) (int, string) {
+			if req.Path == "/drive/file-entries" {
+				return 200, indexPage(t, 1, 1, map[string]any{"id": 5, "name": name, "type": "text", "file_size": 4})
+			}
+			return 200, `evil`
+		})
+
+		dir := t.TempDir()
+		dest := filepath.Join(dir, "backup")
+		if _, err := c.BackupAll(context.Background(), dest, nil); err == nil {
+			t.Errorf("BackupAll with entry %q succeeded, want an error", name)
+		}
+		if n := len(ft.requestsTo("GET", "/file-entries/5/download")); n != 0 {
+			t.Errorf("BackupAll downloaded entry %q", name)
+		}
+		if files, _ := os.ReadDir(dir); len(files) > 1 {
+			t.Errorf("BackupAll wrote outside the destination: %v", files)
+		}
+	}
+}
+
+func TestChildCount_Root(t *testing.T) {
+	c, _ := newFakeClient(t, func(req recordedRequest) (int, string) {
+		// The server counts entries everywhere, not just in the root.
+		return 200, indexPage(t, 1, 1, folderEntry(1, "a", nil), folderEntry(2, "b", nil), folderEntry(3, "c", Ptr[int64](1)))
+	})
+
+	n, err := c.ChildCount(context.Background(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 2 {
+		t.Errorf("ChildCount(nil) = %v, want 2", n)
+	}
+}
+
+func TestUploadFile_OverwriteOnlyExactFile(t *testing.T) {
+	c, ft := newFakeClient(t, uploadHandler(func(req recordedRequest) (int, string) {
+		switch req.Path {
+		case "/drive/file-entries":
+			// The server ignores parentIds and matches names loosely.
+			return 200, indexPage(t, 1, 1,
+				map[string]any{"id": 51, "name": "X.txt", "type": "text", "parent_id": nil},
+				map[string]any{"id": 52, "name": "x.txt", "type": "text", "parent_id": 9},
+				folderEntry(53, "x.txt", nil),
+				map[string]any{"id": 54, "name": "x.txt", "type": "text", "parent_id": nil},
+			)
+		case "/file-entries":
+			return 200, `{"status":"success"}`
+		}
+		return 500, `{"message":"unexpected request"}`
+	}), WithMaxFileSize(-1))
+
+	if err := c.UploadFile(context.Background(), "x.txt", strings.NewReader("hello"), "text/plain", nil, true); err != nil {
+		t.Fatalf("UploadFile: %v", err)
+	}
+	deletes := ft.requestsTo("POST", "/file-entries")
+	if len(deletes) != 1 || !bytes.Contains(deletes[0].Body, []byte(`"entryIds":["54"]`)) {
+		t.Errorf("delete requests = %v, want one deleting only entry 54", deletes)
+	}
+}
+
+func TestUploadFileChunked_RetriesFailedChunk(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "a.txt")
+	if err := os.WriteFile(filePath, []byte("hello world"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	var failed bool
+	c, ft := newFakeClient(t, func(req recordedRequest) (int, string) {
+		switch {
+		case req.Method == "POST" && req.Path == "/tus/upload":
+			return 201, ``
+		case req.Method == "PATCH":
+			if req.Header.Get("Upload-Offset") == "4" && !failed {
+				failed = true
+				return 500, `{"message":"try again"}`
+			}
+			return 204, ``
+		case req.Method == "HEAD":
+			return 200, ``
+		case req.Path == "/tus/entries":
+			return 201, `{"status":"success","fileEntry":{"id":5,"name":"a.txt"}}`
+		}
+		return 500, `{"message":"unexpected request"}`
+	}, WithRetry(1, 0), WithResponseInspector(func(resp *http.Response) error {
+		switch resp.Request.Method {
+		case "POST":
+			resp.Header.Set("Location", "https://example.com/api/v1/tus/upload/key1")
+		case "HEAD":
+			resp.Header.Set("Upload-Offset", "6") // part of the failed chunk arrived
+		}
+		return nil
+	}))
+
+	result, err := c.UploadFileChunked(context.Background(), filePath, nil, &ChunkedUploadOptions{ChunkSize: 4})
+	if err != nil {
+		t.Fatalf("UploadFileChunked: %v (requests %v)", err, ft.paths())
+	}
+	if result.ID != 5 {
+		t.Errorf("result ID = %v, want 5", result.ID)
+	}
+	var offsets []string
+	for _, req := range ft.requestsTo("PATCH", "/tus/upload/key1") {
+		offsets = append(offsets, req.Header.Get("Upload-Offset"))
+	}
+	if want := []string{"0", "4", "6", "10"}; !slices.Equal(offsets, want) {
+		t.Errorf("chunk offsets = %v, want %v", offsets, want)
+	}
+}
+
+func TestWithUploadBandwidthLimit(t *testing.T) {
+	for _, limit := range []int64{0, -1} {
+		if _, err := NewClientWithAPIToken("https://example.com/api/v1", "token", false, WithUploadBandwidthLimit(limit)); err == nil {
+			t.Errorf("WithUploadBandwidthLimit(%v) succeeded, want an error", limit)
+		}
+	}
+
+	c, ft := newFakeClient(t, uploadHandler(func(req recordedRequest) (int, string) {
+		return 500, `{"message":"unexpected request"}`
+	}), WithUploadBandwidthLimit(4000), WithMaxFileSize(-1))
+
+	// The first 4000 bytes fit in the burst; the rest must wait about 0.5s.
+	content := strings.Repeat("x", 6000)
+	start := time.Now()
+	if err := c.UploadFile(context.Background(), "x.txt", strings.NewReader(content), "text/plain", nil, false); err != nil {
+		t.Fatalf("UploadFile: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed < 400*time.Millisecond {
+		t.Errorf("upload of %v bytes at 4000 bytes/s took %v, want at least 400ms", len(content), elapsed)
+	}
+	if reqs := ft.requestsTo("POST", "/uploads"); len(reqs) != 1 || !bytes.Contains(reqs[0].Body, []byte(content)) {
+		t.Errorf("upload requests = %v, want one holding the whole content", ft.paths())
+	}
+}
+
+func TestThrottledReader_ReadsAtMostBurst(t *testing.T) {
+	limiter := rate.NewLimiter(rate.Inf, 10)
+	r := &throttledReader{ReadCloser: io.NopCloser(strings.NewReader(strings.Repeat("x", 100))), ctx: context.Background(), limiter: limiter}
+	buf := make([]byte, 50)
+	n, err := r.Read(buf)
+	if err != nil || n != 10 {
+		t.Errorf("Read = %v, %v; want 10 bytes, the limiter's burst", n, err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	r = &throttledReader{ReadCloser: io.NopCloser(strings.NewReader("xyz")), ctx: ctx, limiter: rate.NewLimiter(1, 1)}
+	if _, err := r.Read(buf[:1]); err == nil {
+		t.Error("Read with a cancelled context succeeded, want an error")
+	}
+}
+
+func TestEmptyTrash_ContinuesPastFailedBatch(t *testing.T) {
+	c, ft := newFakeClient(t, func(req recordedRequest) (int, string) {
+		switch req.Path {
+		case "/drive/file-entries":
+			var entries []map[string]any
+			for id := range int64(5) {
+				entries = append(entries, map[string]any{"id": id + 1, "name": "f", "type": "text"})
+			}
+			return 200, indexPage(t, 1, 1, entries...)
+		case "/file-entries":
+			if bytes.Contains(req.Body, []byte(`"1"`)) {
+				return 500, `{"message":"boom"}`
+			}
+			return 200, `{"status":"success"}`
+		}
+		return 500, `{"message":"unexpected request"}`
+	}, WithDeleteBatchSize(2), WithRetry(0, 0))
+
+	n, err := c.EmptyTrash(context.Background())
+	var delErr *DeleteError
+	if !errors.As(err, &delErr) {
+		t.Fatalf("err = %v, want a *DeleteError", err)
+	}
+	if got, want := delErr.FailedIDs(), []string{"1", "2"}; !slices.Equal(got, want) {
+		t.Errorf("FailedIDs = %v, want %v", got, want)
+	}
+	if n != 3 {
+		t.Errorf("EmptyTrash = %v, want 3 purged", n)
+	}
+	if got := len(ft.requestsTo("POST", "/file-entries")); got != 3 {
+		t.Errorf("got %v delete requests, want 3", got)
+	}
+}
+
+func TestTrashOlderThan_SkipsFolders(t *testing.T) {
+	old := time.Now().Add(-48 * time.Hour).UTC().Format(time.RFC3339)
+	recent := time.Now().UTC().Format(time.RFC3339)
+	c, ft := newFakeClient(t, func(req recordedRequest) (int, string) {
+		switch req.Path {
+		case "/drive/file-entries":
+			if req.Query.Get("parentIds") == "7" {
+				return 200, indexPage(t, 1, 1, map[string]any{"id": 8, "name": "new.txt", "type": "text", "parent_id": 7, "updated_at": recent})
+			}
+			folder := folderEntry(7, "old", nil)
+			folder["updated_at"] = old
+			return 200, indexPage(t, 1, 1, folder, map[string]any{"id": 9, "name": "old.txt", "type": "text", "updated_at": old})
+		case "/file-entries":
+			return 200, `{"status":"success"}`
+		}
+		return 500, `{"message":"unexpected request"}`
+	})
+
+	for _, recursive := range []bool{false, true} {
+		deleted, err := c.TrashOlderThanWithOptions(context.Background(), nil, time.Hour, &TrashOptions{DeleteForever: true, Recursive: recursive})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(deleted) != 1 || deleted[0].ID != 9 {
+			t.Errorf("recursive=%v: deleted %+v, want only old.txt", recursive, deleted)
+		}
+	}
+	for _, req := range ft.requestsTo("POST", "/file-entries") {
+		if bytes.Contains(req.Body, []byte(`"7"`)) {
+			t.Errorf("deleted the old folder holding a new file: %s", req.Body)
+		}
+	}
+}
+
+func TestApplyMetadata_RejectsNonLocalPaths(t *testing.T) {
+	t.Parallel()
+	for _, path := range []string{"../outside.txt", "a/../../outside.txt", "/etc/passwd", ""} {
+		dir := t.TempDir()
+		victim := filepath.Join(dir, "a.txt")
+		if err := os.WriteFile(victim, []byte("x"), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		buf, err := json.Marshal(&metadataFile{Version: 1, Files: []FileMetadata{
+			{Path: "a.txt", Mode: 0o600, ModTime: time.Unix(0, 0)},
+			{Path: path, Mode: 0o777, ModTime: time.Unix(0, 0)},
+		}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, MetadataFileName), buf, 0o644); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := ApplyMetadata(dir); err == nil {
+			t.Errorf("ApplyMetadata(%q) = nil, want error", path)
+		}
+		fi, err := os.Stat(victim)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := fi.Mode().Perm(); got != 0o644 {
+			t.Errorf("path %q: a.txt mode = %v, want it left alone", path, got)
+		}
+	}
+}
+
+func TestGetOrCreateFolder_CachesWithPlainClient(t *testing.T) {
+	ft := &fakeTransport{handler: func(req recordedRequest) (int, string) {
+		if req.Path == "/drive/file-entries" {
+			return 200, indexPage(t, 1, 1, folderEntry(7, "docs", nil))
+		}
+		return 500, `{"message":"unexpected request"}`
+	}}
+	c, err := NewClient("https://example.com/api/v1", WithHTTPClient(ft))
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+
+	ctx := context.Background()
+	for range 2 {
+		id, err := c.GetOrCreateFolder(ctx, "docs", nil)
+		if err != nil {
+			t.Fatalf("GetOrCreateFolder: %v", err)
+		}
+		if *id != 7 {
+			t.Errorf("id = %v, want 7", *id)
+		}
+	}
+	if got := ft.requestsTo("GET", "/drive/file-entries"); len(got) != 1 {
+		t.Errorf("listed %v times, want 1 (the second call should use the cache)", len(got))
+	}
+}
+
+func TestGetOrCreateFolder_ConcurrentWithPlainClient(t *testing.T) {
+	var (
+		mu      sync.Mutex
+		created bool
+	)
+	ft := &fakeTransport{handler: func(req recordedRequest) (int, string) {
+		mu.Lock()
+		defer mu.Unlock()
+		switch req.Path {
+		case "/drive/file-entries":
+			if created {
+				return 200, indexPage(t, 1, 1, folderEntry(7, "docs", nil))
+			}
+			return 200, indexPage(t, 1, 1)
+		case "/folders":
+			created = true
+			return 200, `{"status":"success","folder":{"id":7}}`
+		}
+		return 500, `{"message":"unexpected request"}`
+	}}
+	c, err := NewClient("https://example.com/api/v1", WithHTTPClient(ft))
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Go(func() {
+			id, err := c.GetOrCreateFolder(context.Background(), "docs", nil)
+			if err != nil {
+				t.Errorf("GetOrCreateFolder: %v", err)
+				return
+			}
+			if *id != 7 {
+				t.Errorf("id = %v, want 7", *id)
+			}
+		})
+	}
+	wg.Wait()
+
+	if got := ft.requestsTo("POST", "/folders"); len(got) != 1 {
+		t.Errorf("created %v folders, want 1", len(got))
+	}
+}
+
+func TestUploadFileChunked_GivesUpOnRepeatedConflicts(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "a.txt")
+	if err := os.WriteFile(filePath, []byte("hello world"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	c, ft := newFakeClient(t, func(req recordedRequest) (int, string) {
+		switch req.Method {
+		case "POST":
+			return 201, ``
+		case "PATCH":
+			return 409, `{"message":"offset mismatch"}`
+		case "HEAD":
+			return 200, ``
+		}
+		return 500, `{"message":"unexpected request"}`
+	}, WithResponseInspector(func(resp *http.Response) error {
+		switch resp.Request.Method {
+		case "POST":
+			resp.Header.Set("Location", "https://example.com/api/v1/tus/upload/key1")
+		case "HEAD":
+			resp.Header.Set("Upload-Offset", "0")
+		}
+		return nil
+	}))
+
+	_, err := c.UploadFileChunked(context.Background(), filePath, nil, &ChunkedUploadOptions{ChunkSize: 4})
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != 409 {
+		t.Fatalf("UploadFileChunked error = %v, want the 409", err)
+	}
+	if n := len(ft.requestsTo("PATCH", "/tus/upload/key1")); n != maxChunkConflicts+1 {
+		t.Errorf("sent %v chunks, want %v", n, maxChunkConflicts+1)
+	}
+}
+
+func TestUploadFileChunked_RetriesThrottlingOnce(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "a.txt")
+	if err := os.WriteFile(filePath, []byte("hello"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	c, ft := newFakeClient(t, func(req recordedRequest) (int, string) {
+		switch req.Method {
+		case "POST":
+			return 201, ``
+		case "PATCH":
+			return 503, `{"message":"busy"}`
+		case "HEAD":
+			return 200, ``
+		}
+		return 500, `{"message":"unexpected request"}`
+	}, WithRetry(2, 0), WithResponseInspector(func(resp *http.Response) error {
+		if resp.Request.Method == "POST" {
+			resp.Header.Set("Location", "https://example.com/api/v1/tus/upload/key1")
+		}
+		return nil
+	}))
+
+	if _, err := c.UploadFileChunked(context.Background(), filePath, nil, nil); err == nil {
+		t.Fatal("UploadFileChunked succeeded, want the 503")
+	}
+	// The transport's two retries are all; the chunk loop does not repeat them.
+	if n := len(ft.requestsTo("PATCH", "/tus/upload/key1")); n != 3 {
+		t.Errorf("sent %v chunks, want 3", n)
+	}
+}
+
+func TestBatchUploadFiles_Name(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "a.txt")
+	if err := os.WriteFile(filePath, []byte("hello"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	c, ft := newFakeClient(t, uploadHandler(func(req recordedRequest) (int, string) {
+		return 500, `{"message":"unexpected request"}`
+	}), WithMaxFileSize(-1))
+
+	if _, err := c.BatchUploadFiles(context.Background(), []UploadSpec{{LocalPath: filePath, Name: "b.md"}}); err != nil {
+		t.Fatalf("BatchUploadFiles: %v", err)
+	}
+	reqs := ft.requestsTo("POST", "/uploads")
+	if len(reqs) != 1 || !bytes.Contains(reqs[0].Body, []byte(`filename="b.md"`)) {
+		t.Errorf("uploads = %v, want one of b.md", ft.paths())
+	}
+}
@@ -0,0 +1,60 @@
+package folderfort
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// WithRequestEditor adds fn to the functions run on every request the client
+// sends, such as to add an X-Request-ID header for tracing or auditing.
+// Editors run in the order they were added, before the Authorization header
+// is set, so they cannot replace the API token. If fn returns an error, the
+// request is not sent and the call fails with that error.
+// Unlike WithRequestEditorFn, which only applies to the generated API
+// methods, the editors also see requests made by the higher-level helpers.
+func WithRequestEditor(fn func(*http.Request) error) ClientOption {
+	return withDoer(func(d *doerWithToken) error {
+		if fn == nil {
+			return fmt.Errorf("request editor must not be nil")
+		}
+		d.requestEditors = append(d.requestEditors, fn)
+		return nil
+	})
+}
+
+// WithResponseInspector adds fn to the functions run on every response the
+// client receives, such as to record metrics or rate-limit headers.
+// Inspectors run in the order they were added, once per call after any
+// retries, and must not consume the response body. If fn returns an error,
+// the response body is closed and the call fails with that error.
+func WithResponseInspector(fn func(*http.Response) error) ClientOption {
+	return withDoer(func(d *doerWithToken) error {
+		if fn == nil {
+			return fmt.Errorf("response inspector must not be nil")
+		}
+		d.responseInspectors = append(d.responseInspectors, fn)
+		return nil
+	})
+}
+
+// editRequest applies the editors added by WithRequestEditor to req.
+func (d *doerWithToken) editRequest(req *http.Request) error {
+	for _, fn := range d.requestEditors {
+		if err := fn(req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// inspectResponse applies the inspectors added by WithResponseInspector to resp,
+// closing its body if one of them fails.
+func (d *doerWithToken) inspectResponse(resp *http.Response) error {
+	for _, fn := range d.responseInspectors {
+		if err := fn(resp); err != nil {
+			resp.Body.Close()
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,31 @@
+package folderfort
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+)
+
+// idempotencyKeyHeader is the request header carrying an upload's idempotency key.
+const idempotencyKeyHeader = "Idempotency-Key"
+
+// newIdempotencyKey returns a random key identifying one logical upload.
+func newIdempotencyKey() (string, error) {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return "", fmt.Errorf("failed to generate idempotency key: %w", err)
+	}
+	return hex.EncodeToString(b[:]), nil
+}
+
+// WithIdempotencyKeys attaches a unique Idempotency-Key header to every file
+// upload. The key is generated once per call to UploadFile (or UploadFileFromPath)
+// and is kept on the request, so a retried request reuses it and a server
+// that supports idempotency keys will not store the file twice.
+// Servers that do not support the header ignore it. The default is off.
+func WithIdempotencyKeys(enabled bool) ClientOption {
+	return withDoer(func(d *doerWithToken) error {
+		d.idempotencyKeys = enabled
+		return nil
+	})
+}
@@ -0,0 +1,153 @@
+package folderfort
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+)
+
+// DefaultMaxFileSize is the upload size limit used when neither
+// WithMaxFileSize nor the server specifies one.
+const DefaultMaxFileSize = 100 * 1024 * 1024
+
+// ErrFileTooLarge is returned by UploadFile when the file exceeds the upload size limit.
+var ErrFileTooLarge = errors.New("file exceeds the maximum upload size")
+
+type uploadConfigResponse struct {
+	MaxSize int64 `json:"max_size"`
+}
+
+// serverLimitErrorTTL is how long an error response to the upload size
+// limit request is remembered before the server is asked again.
+const serverLimitErrorTTL = time.Minute
+
+// serverLimit caches the server's upload size limit. Only one request for
+// it is in flight at a time, and the lock is not held during the request.
+type serverLimit struct {
+	mu       sync.Mutex
+	fetching chan struct{} // closed when the request in flight finishes
+	done     bool          // whether size and err hold the server's answer
+	size     int64
+	err      error
+	expires  time.Time // when err stops being used
+}
+
+// valid reports whether the cached answer can be used. l.mu must be held.
+func (l *serverLimit) valid() bool {
+	return l.done && (l.err == nil || time.Now().Before(l.expires))
+}
+
+// cached returns the server's answer if it has been fetched.
+func (l *serverLimit) cached() (size int64, ok bool, err error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.size, l.valid(), l.err
+}
+
+// ServerMaxUploadSize returns the maximum size of a single upload in bytes as
+// advertised by the server, or 0 if the server imposes no limit.
+// The limit is fetched once and cached for the lifetime of the client.
+// An error response from the server is cached for a minute, and a request
+// that fails without a response, such as on a network error or a cancelled
+// ctx, is not cached at all. Concurrent callers share a single request.
+func (c *Client) ServerMaxUploadSize(ctx context.Context) (int64, error) {
+	l := &c.doer().serverMaxSize
+	for {
+		l.mu.Lock()
+		if l.valid() {
+			l.mu.Unlock()
+			return l.size, l.err
+		}
+		if wait := l.fetching; wait != nil {
+			l.mu.Unlock()
+			select {
+			case <-wait:
+				continue
+			case <-ctx.Done():
+				return 0, ctx.Err()
+			}
+		}
+		wait := make(chan struct{})
+		l.fetching = wait
+		l.mu.Unlock()
+
+		size, answered, err := c.fetchServerMaxUploadSize(ctx)
+		l.mu.Lock()
+		if answered {
+			l.done, l.size, l.err = true, size, err
+			l.expires = time.Now().Add(serverLimitErrorTTL)
+		}
+		l.fetching = nil
+		l.mu.Unlock()
+		close(wait)
+		return size, err
+	}
+}
+
+// fetchServerMaxUploadSize asks the server for its upload size limit.
+// answered reports whether the server responded, in which case the result
+// is worth caching for a while even if it is an error.
+func (c *Client) fetchServerMaxUploadSize(ctx context.Context) (size int64, answered bool, err error) {
+	resp, err := c.UploadConfig(ctx)
+	if err != nil {
+		return 0, false, fmt.Errorf("c.UploadConfig: %w", err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return 0, false, fmt.Errorf("io.ReadAll: %w", err)
+	}
+
+	if resp.StatusCode != 200 {
+		return 0, true, fmt.Errorf("failed to get upload config: %w", newAPIError(resp.StatusCode, body))
+	}
+	if err := checkEnvelope(resp.StatusCode, body); err != nil {
+		return 0, true, err
+	}
+
+	var configResp uploadConfigResponse
+	if err := json.Unmarshal(body, &configResp); err != nil {
+		return 0, true, fmt.Errorf("failed to parse upload config response: %w\n%s", err, body)
+	}
+	return configResp.MaxSize, true, nil
+}
+
+// maxUploadSize returns the upload size limit in bytes, or 0 for no limit.
+// An explicit WithMaxFileSize takes precedence, then the server's advertised
+// limit, then DefaultMaxFileSize if the server could not be asked.
+func (c *Client) maxUploadSize(ctx context.Context) int64 {
+	switch size := c.doer().maxFileSize; {
+	case size > 0:
+		return size
+	case size < 0:
+		return 0
+	}
+
+	size, err := c.ServerMaxUploadSize(ctx)
+	if err != nil {
+		c.doer().logf("ServerMaxUploadSize: %v (using default of %v bytes)", err, DefaultMaxFileSize)
+		return DefaultMaxFileSize
+	}
+	return size
+}
+
+// localMaxUploadSize is like maxUploadSize but never makes an API call.
+// It uses the server's limit only if it has already been fetched.
+func (c *Client) localMaxUploadSize() int64 {
+	d := c.doer()
+	switch {
+	case d.maxFileSize > 0:
+		return d.maxFileSize
+	case d.maxFileSize < 0:
+		return 0
+	}
+
+	if size, ok, err := d.serverMaxSize.cached(); ok && err == nil {
+		return size
+	}
+	return DefaultMaxFileSize
+}
@@ -0,0 +1,47 @@
+package folderfort
+
+import (
+	"log/slog"
+)
+
+// Logger receives the client's diagnostic messages, such as skipped files and
+// ignored errors. A *log.Logger satisfies it.
+type Logger interface {
+	Printf(format string, v ...any)
+}
+
+// nopLogger is the default Logger. It discards everything.
+type nopLogger struct{}
+
+func (nopLogger) Printf(string, ...any) {}
+
+// logf sends a diagnostic message to the configured Logger.
+func (d *doerWithToken) logf(format string, v ...any) {
+	d.logger.Printf(format, v...)
+}
+
+// WithPrintfLogger sends the client's diagnostic messages to l, for example
+// log.Default(). By default they are discarded.
+func WithPrintfLogger(l Logger) ClientOption {
+	return withDoer(func(d *doerWithToken) error {
+		if l == nil {
+			l = nopLogger{}
+		}
+		d.logger = l
+		return nil
+	})
+}
+
+// WithLogger sends structured events to l: file uploads starting (at Debug)
+// and completing with their size and duration, folders being created (at Info),
+// and requests being retried (at Warn). By default they are discarded.
+// Unstructured diagnostics go to the Logger set by WithPrintfLogger instead.
+func WithLogger(l *slog.Logger) ClientOption {
+	return withDoer(func(d *doerWithToken) error {
+		if l == nil {
+			l = slog.New(slog.DiscardHandler)
+		}
+		d.slog = l
+		return nil
+	})
+}
@@ -0,0 +1,93 @@
+package folderfort
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+)
+
+// MetadataFileName is the name of the sidecar file written to the top of an
+// uploaded tree by UploadOptions.WriteMetadata.
+const MetadataFileName = ".folderfort-metadata.json"
+
+// FileMetadata records the local attributes of an uploaded file or folder
+// that FolderFort itself does not store.
+type FileMetadata struct {
+	// Path is relative to the uploaded directory and uses forward slashes.
+	Path    string      `json:"path"`
+	Mode    fs.FileMode `json:"mode"`
+	ModTime time.Time   `json:"mtime"`
+}
+
+type metadataFile struct {
+	Version int            `json:"version"`
+	Files   []FileMetadata `json:"files"`
+}
+
+// WithPreserveModTime sends the modification time of each local file
+// uploaded by UploadFileFromPath (and therefore UploadDirectory) to the
+// server, so that the remote entry's UpdatedAt reflects the source file
+// rather than the time of the upload, which keeps size and time comparisons
+// such as UploadOptions.SkipUnchanged reliable. Not every server honors it;
+// those that do not ignore it. See also UploadOptions.WriteMetadata, which
+// records modification times in a sidecar file instead. The default is off.
+func WithPreserveModTime(enabled bool) ClientOption {
+	return withDoer(func(d *doerWithToken) error {
+		d.preserveModTime = enabled
+		return nil
+	})
+}
+
+// uploadMetadata uploads the sidecar metadata file into the folder parentID.
+func (c *Client) uploadMetadata(ctx context.Context, metadata []FileMetadata, parentID *int64) error {
+	buf, err := json.MarshalIndent(&metadataFile{Version: 1, Files: metadata}, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal metadata: %w", err)
+	}
+	if _, err := c.uploadFile(ctx, MetadataFileName, bytes.NewReader(buf), "application/json", parentID, true); err != nil {
+		return fmt.Errorf("failed to upload metadata: %w", err)
+	}
+	return nil
+}
+
+// ApplyMetadata restores the file modes and modification times recorded in
+// the MetadataFileName file at the top of the local directory dir.
+// Paths that no longer exist are ignored. Since the file comes from the
+// server, any recorded path that is not local to dir is rejected.
+func ApplyMetadata(dir string) error {
+	buf, err := os.ReadFile(filepath.Join(dir, MetadataFileName))
+	if err != nil {
+		return err
+	}
+	var mf metadataFile
+	if err := json.Unmarshal(buf, &mf); err != nil {
+		return fmt.Errorf("failed to parse %v: %w", MetadataFileName, err)
+	}
+	for _, md := range mf.Files {
+		if !filepath.IsLocal(filepath.FromSlash(md.Path)) {
+			return fmt.Errorf("invalid path %q in %v", md.Path, MetadataFileName)
+		}
+	}
+
+	// Folders are recorded before their contents, so apply in reverse to keep
+	// a folder's modification time from being changed by its children.
+	for _, md := range slices.Backward(mf.Files) {
+		localPath := filepath.Join(dir, filepath.FromSlash(md.Path))
+		if err := os.Chmod(localPath, md.Mode.Perm()); err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return err
+		}
+		if err := os.Chtimes(localPath, md.ModTime, md.ModTime); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,135 @@
+package folderfort
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+	"path/filepath"
+	"strings"
+)
+
+// defaultMIMEType is used when no MIME type is known for a file extension.
+const defaultMIMEType = "application/octet-stream"
+
+// builtinMIMETypes maps lowercase file extensions to MIME types. It takes
+// precedence over the host's MIME database, which is missing or inconsistent
+// for many of these across platforms.
+var builtinMIMETypes = map[string]string{
+	".bz2":   "application/x-bzip2",
+	".c":     "text/x-c",
+	".conf":  "text/plain; charset=utf-8",
+	".cpp":   "text/x-c++",
+	".css":   "text/css; charset=utf-8",
+	".csv":   "text/csv; charset=utf-8",
+	".dart":  "text/x-dart",
+	".diff":  "text/x-diff",
+	".gif":   "image/gif",
+	".go":    "text/x-go; charset=utf-8",
+	".gz":    "application/gzip",
+	".h":     "text/x-c",
+	".htm":   "text/html; charset=utf-8",
+	".html":  "text/html; charset=utf-8",
+	".ico":   "image/vnd.microsoft.icon",
+	".ini":   "text/plain; charset=utf-8",
+	".java":  "text/x-java-source",
+	".jpeg":  "image/jpeg",
+	".jpg":   "image/jpeg",
+	".js":    "text/javascript; charset=utf-8",
+	".json":  "application/json",
+	".jsonl": "application/jsonl",
+	".log":   "text/plain; charset=utf-8",
+	".md":    "text/markdown; charset=utf-8",
+	".mjs":   "text/javascript; charset=utf-8",
+	".mp3":   "audio/mpeg",
+	".mp4":   "video/mp4",
+	".patch": "text/x-diff",
+	".pdf":   "application/pdf",
+	".png":   "image/png",
+	".proto": "text/plain; charset=utf-8",
+	".py":    "text/x-python; charset=utf-8",
+	".rs":    "text/x-rust; charset=utf-8",
+	".sh":    "application/x-sh",
+	".sql":   "application/sql",
+	".svg":   "image/svg+xml",
+	".tar":   "application/x-tar",
+	".toml":  "application/toml",
+	".ts":    "text/x-typescript; charset=utf-8",
+	".tsx":   "text/x-typescript; charset=utf-8",
+	".txt":   "text/plain; charset=utf-8",
+	".wasm":  "application/wasm",
+	".webm":  "video/webm",
+	".webp":  "image/webp",
+	".xml":   "application/xml",
+	".yaml":  "application/yaml",
+	".yml":   "application/yaml",
+	".zip":   "application/zip",
+	".zst":   "application/zstd",
+}
+
+// mimeTypeByExtension returns the MIME type for filePath based on its
+// extension, consulting WithMIMETypes overrides, then the built-in table,
+// then the host's MIME database, and finally defaulting to
+// "application/octet-stream".
+func (d *doerWithToken) mimeTypeByExtension(filePath string) string {
+	if t, ok := d.lookupMIMEType(filePath); ok {
+		return t
+	}
+	return defaultMIMEType
+}
+
+// lookupMIMEType is like mimeTypeByExtension but reports whether the
+// extension was recognized instead of falling back to a default.
+func (d *doerWithToken) lookupMIMEType(filePath string) (string, bool) {
+	ext := strings.ToLower(filepath.Ext(filePath))
+	if ext == "" {
+		return "", false
+	}
+	if t, ok := d.mimeTypes[ext]; ok {
+		return t, true
+	}
+	if t, ok := builtinMIMETypes[ext]; ok {
+		return t, true
+	}
+	if t := mime.TypeByExtension(ext); t != "" {
+		return t, true
+	}
+	return "", false
+}
+
+// sniffLen is the number of bytes considered by http.DetectContentType.
+const sniffLen = 512
+
+// sniffMIMEType detects the MIME type of the content of r with
+// http.DetectContentType, defaulting to "application/octet-stream".
+// Since that consumes the start of r, it also returns a reader that yields
+// the complete content, with the consumed bytes put back in front.
+func sniffMIMEType(r io.Reader) (string, io.Reader, error) {
+	head := make([]byte, sniffLen)
+	n, err := io.ReadFull(r, head)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return "", nil, err
+	}
+	head = head[:n]
+	return http.DetectContentType(head), io.MultiReader(bytes.NewReader(head), r), nil
+}
+
+// WithMIMETypes adds to or overrides the built-in table of MIME types used by
+// UploadFileFromPath and UploadDirectory. Keys are file extensions including
+// the leading dot (e.g. ".md") and are matched case-insensitively.
+// It may be used more than once; later mappings win.
+func WithMIMETypes(types map[string]string) ClientOption {
+	return withDoer(func(d *doerWithToken) error {
+		if d.mimeTypes == nil {
+			d.mimeTypes = map[string]string{}
+		}
+		for ext, t := range types {
+			if !strings.HasPrefix(ext, ".") {
+				return errors.New("MIME type extensions must start with '.'")
+			}
+			d.mimeTypes[strings.ToLower(ext)] = t
+		}
+		return nil
+	})
+}
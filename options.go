@@ -0,0 +1,135 @@
+package folderfort
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// withDoer returns a ClientOption that applies fn to the doerWithToken
+// installed by NewClientWithAPIToken.
+func withDoer(fn func(d *doerWithToken) error) ClientOption {
+	return func(c *Client) error {
+		d, ok := c.Client.(*doerWithToken)
+		if !ok {
+			return errors.New("option requires a client created by NewClientWithAPIToken")
+		}
+		return fn(d)
+	}
+}
+
+// WithUploadDelay sets the pause after each file uploaded by UploadDirectory.
+// The default is 500ms. A zero or negative value disables the pause.
+// It is ignored if WithRateLimit is used.
+func WithUploadDelay(delay time.Duration) ClientOption {
+	return withDoer(func(d *doerWithToken) error {
+		d.uploadDelay = delay
+		return nil
+	})
+}
+
+// WithFolderCreationDelay sets the pause before each folder is created by
+// GetOrCreateFolder (and therefore UploadFile and UploadDirectory).
+// It is independent of WithUploadDelay so that deep trees, which create
+// many folders in a burst, can be throttled separately from file uploads.
+// The default is no pause. Like WithUploadDelay, it is ignored if
+// WithRateLimit is used, since the rate limiter already throttles folder
+// creation along with every other request.
+func WithFolderCreationDelay(delay time.Duration) ClientOption {
+	return withDoer(func(d *doerWithToken) error {
+		d.folderDelay = delay
+		return nil
+	})
+}
+
+// WithCopyBufferSize sets the buffer size used when streaming file contents
+// to or from FolderFort. The default is DefaultCopyBufferSize.
+func WithCopyBufferSize(size int) ClientOption {
+	return withDoer(func(d *doerWithToken) error {
+		if size <= 0 {
+			return fmt.Errorf("copy buffer size must be positive, got %v", size)
+		}
+		d.copyBufferSize = size
+		return nil
+	})
+}
+
+// WithAutoCreateParents controls whether UploadFile creates the parent
+// folders named in its fileName when they do not already exist.
+// The default is true. When false, UploadFile returns ErrParentNotFound instead,
+// which helps catch mistakes in remote paths.
+func WithAutoCreateParents(create bool) ClientOption {
+	return withDoer(func(d *doerWithToken) error {
+		d.autoCreateParents = create
+		return nil
+	})
+}
+
+// WithMaxFileSize sets the upload size limit in bytes. UploadFile returns
+// ErrFileTooLarge for larger files and UploadDirectory skips them (or fails,
+// if UploadOptions.ErrorOnTooLarge is set).
+// By default (or with a size of 0) the limit advertised by the server is used,
+// falling back to DefaultMaxFileSize. A negative size disables the limit.
+func WithMaxFileSize(size int64) ClientOption {
+	return withDoer(func(d *doerWithToken) error {
+		d.maxFileSize = size
+		return nil
+	})
+}
+
+// WithNameMatcher sets the rule used to decide whether an existing entry's
+// name matches the name being looked up, for example by GetOrCreateFolder
+// when deciding whether a folder already exists. The default is
+// CaseInsensitiveNameMatch; pass ExactNameMatch for case-sensitive matching.
+// It does not apply to the files replaced by an upload with overwrite, which
+// must have exactly the uploaded name.
+func WithNameMatcher(m NameMatcher) ClientOption {
+	return withDoer(func(d *doerWithToken) error {
+		if m == nil {
+			return errors.New("name matcher must not be nil")
+		}
+		d.nameMatcher = m
+		return nil
+	})
+}
+
+// WithParentMatch sets how entries found by a lookup by name, for example by
+// GetOrCreateFolder, are checked against the folder being searched. The
+// default is MatchParentID; MatchParentPath trusts each entry's Path instead,
+// so that GetOrCreateFolder("a/b") reuses an existing "a/b" even when the
+// server's parent filter and ParentID fields disagree.
+func WithParentMatch(m ParentMatch) ClientOption {
+	return withDoer(func(d *doerWithToken) error {
+		if m != MatchParentID && m != MatchParentPath {
+			return fmt.Errorf("invalid parent match %v", m)
+		}
+		d.parentMatch = m
+		return nil
+	})
+}
+
+// WithDeleteBatchSize sets the number of entries deleted per request by
+// DeleteEntriesByID, DeleteEntriesForeverByID and the bulk helpers built on them,
+// such as EmptyTrash. The default is 100.
+func WithDeleteBatchSize(n int) ClientOption {
+	return withDoer(func(d *doerWithToken) error {
+		if n <= 0 {
+			return fmt.Errorf("delete batch size must be positive, got %v", n)
+		}
+		d.deleteBatchSize = n
+		return nil
+	})
+}
+
+// WithConcurrency sets the number of files UploadDirectory uploads in
+// parallel. Folders are still created one at a time. The default is 1.
+// When n > 1, the WithUploadDelay pause applies to each worker separately.
+func WithConcurrency(n int) ClientOption {
+	return withDoer(func(d *doerWithToken) error {
+		if n <= 0 {
+			return fmt.Errorf("concurrency must be positive, got %v", n)
+		}
+		d.concurrency = n
+		return nil
+	})
+}
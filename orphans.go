@@ -0,0 +1,79 @@
+package folderfort
+
+import (
+	"context"
+	"fmt"
+	"slices"
+)
+
+// orphanParentBatchSize is the most trashed folders whose children are
+// listed in a single request.
+const orphanParentBatchSize = 100
+
+// OrphanOptions configures FindOrphansWithOptions.
+type OrphanOptions struct {
+	// RecoverTo, if not empty, is the name of a folder in the root folder
+	// (created if necessary) that all orphaned entries are moved into.
+	RecoverTo string
+}
+
+// FindOrphans returns every entry whose parent folder no longer exists,
+// for example because the parent was deleted while an upload was in progress.
+//
+// Listing entries without a parent only returns the root folder, so orphans
+// are found through the trash instead: every live entry whose parent is a
+// trashed folder is reported. Entries whose parent was deleted forever
+// cannot be found this way.
+func (c *Client) FindOrphans(ctx context.Context) ([]Entry, error) {
+	return c.FindOrphansWithOptions(ctx, nil)
+}
+
+// FindOrphansWithOptions is like FindOrphans but can also move the orphaned
+// entries into a recovery folder. A nil opts uses the defaults.
+func (c *Client) FindOrphansWithOptions(ctx context.Context, opts *OrphanOptions) ([]Entry, error) {
+	if opts == nil {
+		opts = &OrphanOptions{}
+	}
+
+	trashed, err := c.listEntries(ctx, IndexEntryParams{DeletedOnly: Ptr(true)})
+	if err != nil {
+		return nil, err
+	}
+	var parentIDs []string
+	for _, e := range trashed {
+		if e.IsFolder() {
+			parentIDs = append(parentIDs, fmt.Sprintf("%v", e.ID))
+		}
+	}
+
+	var orphans []Entry
+	for batch := range slices.Chunk(parentIDs, orphanParentBatchSize) {
+		entries, err := c.listEntries(ctx, IndexEntryParams{ParentIds: &batch})
+		if err != nil {
+			return nil, err
+		}
+		for _, e := range entries {
+			if e.ParentID != nil && slices.Contains(batch, fmt.Sprintf("%v", *e.ParentID)) {
+				orphans = append(orphans, e)
+			}
+		}
+	}
+	if opts.RecoverTo == "" || len(orphans) == 0 {
+		return orphans, nil
+	}
+
+	recoverID, err := c.GetOrCreateFolder(ctx, opts.RecoverTo, nil)
+	if err != nil {
+		return orphans, fmt.Errorf("failed to get recovery folder %q: %w", opts.RecoverTo, err)
+	}
+
+	ids := make([]int64, 0, len(orphans))
+	for _, e := range orphans {
+		ids = append(ids, e.ID)
+	}
+	if err := c.MoveEntries(ctx, ids, recoverID); err != nil {
+		return orphans, err
+	}
+
+	return orphans, nil
+}
@@ -0,0 +1,48 @@
+package folderfort
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+var (
+	// ErrUnauthorized is returned by Ping when the server rejects the
+	// client's credentials (with a 401 or 403). The error also wraps the
+	// *APIError describing the response.
+	ErrUnauthorized = errors.New("folderfort: credentials rejected")
+
+	// ErrUnreachable is returned by Ping when no response could be obtained
+	// from the server, such as after a DNS, connection or TLS failure.
+	ErrUnreachable = errors.New("folderfort: server unreachable")
+)
+
+// Ping makes a cheap authenticated request to check that the server is
+// reachable and accepts the client's credentials, so that tools can fail
+// fast before starting a long job. It returns an error wrapping
+// ErrUnauthorized or ErrUnreachable for those failures, or an *APIError
+// for any other failed response.
+func (c *Client) Ping(ctx context.Context) error {
+	resp, err := c.CurrentUser(ctx)
+	if err != nil {
+		if ctx.Err() != nil || errors.Is(err, ErrClientClosed) {
+			return err
+		}
+		return fmt.Errorf("%w: %w", ErrUnreachable, err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("%w: io.ReadAll: %w", ErrUnreachable, err)
+	}
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return checkEnvelope(resp.StatusCode, body)
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return fmt.Errorf("%w: %w", ErrUnauthorized, newAPIError(resp.StatusCode, body))
+	}
+	return fmt.Errorf("ping failed: %w", newAPIError(resp.StatusCode, body))
+}
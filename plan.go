@@ -0,0 +1,97 @@
+package folderfort
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+)
+
+// UploadPlan describes what UploadDirectoryWithOptions would do for a directory.
+type UploadPlan struct {
+	Files   int   // number of files that would be uploaded
+	Folders int   // number of folders that would be created or reused
+	Bytes   int64 // total size of the files that would be uploaded
+
+	// Skipped lists every local path that would not be uploaded.
+	Skipped []SkipEvent
+}
+
+// PlanUpload walks the local directory dir applying the same exclusion and
+// size rules as UploadDirectoryWithOptions, without making any API calls.
+// The size limit is the one set by WithMaxFileSize, else the server's limit if
+// it has already been fetched, else DefaultMaxFileSize. A nil opts uses the defaults.
+func (c *Client) PlanUpload(dir string, opts *UploadOptions) (*UploadPlan, error) {
+	if opts == nil {
+		opts = &UploadOptions{}
+	}
+
+	ex, err := opts.newExcluder(nil, dir)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := &UploadPlan{}
+	if err := c.planDirectory(dir, "", ex, c.localMaxUploadSize(), map[string]bool{}, plan); err != nil {
+		return nil, err
+	}
+	return plan, nil
+}
+
+// planDirectory adds the contents of directoryPath, whose path relative to the
+// top-level directory is relPath, to plan. ancestors holds the real paths of
+// the directories being walked when following symlinks.
+func (c *Client) planDirectory(directoryPath, relPath string, ex *excluder, maxSize int64, ancestors map[string]bool, plan *UploadPlan) error {
+	entries, err := os.ReadDir(directoryPath)
+	if err != nil {
+		return fmt.Errorf("error reading directory %v: %w", directoryPath, err)
+	}
+
+	if ex.opts.FollowSymlinks {
+		realPath, err := filepath.EvalSymlinks(directoryPath)
+		if err != nil {
+			return fmt.Errorf("error resolving directory %v: %w", directoryPath, err)
+		}
+		ancestors[realPath] = true
+		defer delete(ancestors, realPath)
+	}
+
+	for _, entry := range entries {
+		itemPath := filepath.Join(directoryPath, entry.Name())
+		itemRelPath := path.Join(relPath, entry.Name())
+
+		info, err := entry.Info()
+		if err != nil {
+			return fmt.Errorf("error getting file info for %v: %w", itemPath, err)
+		}
+		if info.Mode()&fs.ModeSymlink != 0 {
+			if info, err = followSymlink(itemPath, ex.opts.FollowSymlinks, ancestors); err != nil {
+				plan.Skipped = append(plan.Skipped, SkipEvent{Path: itemRelPath, Reason: SkippedSymlink})
+				continue
+			}
+		}
+
+		if ex.excluded(entry.Name(), itemPath, itemRelPath, info.IsDir()) {
+			plan.Skipped = append(plan.Skipped, SkipEvent{Path: itemRelPath, Reason: SkippedExcluded})
+			continue
+		}
+
+		if info.IsDir() {
+			plan.Folders++
+			if err := c.planDirectory(itemPath, itemRelPath, ex, maxSize, ancestors, plan); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if maxSize > 0 && info.Size() > maxSize {
+			plan.Skipped = append(plan.Skipped, SkipEvent{Path: itemRelPath, Reason: SkippedTooLarge})
+			continue
+		}
+		plan.Files++
+		plan.Bytes += info.Size()
+	}
+
+	return nil
+}
@@ -0,0 +1,61 @@
+package folderfort
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"sync/atomic"
+)
+
+// ProgressFunc is called repeatedly while a file is uploaded with the number
+// of bytes of the request body sent so far and the total size of the body.
+// The body includes a small amount of multipart framing around the file.
+type ProgressFunc func(bytesSent, totalBytes int64)
+
+// WithUploadProgress registers fn to report the progress of every file upload,
+// for example to drive a progress bar. With WithConcurrency, fn may be called
+// from several goroutines at once. If a request is retried, progress restarts from zero.
+func WithUploadProgress(fn ProgressFunc) ClientOption {
+	return withDoer(func(d *doerWithToken) error {
+		d.progress = fn
+		return nil
+	})
+}
+
+// progressEditor returns a RequestEditorFn that reports the progress of
+// sending the request body to fn.
+func progressEditor(fn ProgressFunc) RequestEditorFn {
+	return func(ctx context.Context, req *http.Request) error {
+		if req.Body == nil {
+			return nil
+		}
+		total := req.ContentLength
+		req.Body = &progressReader{ReadCloser: req.Body, total: total, fn: fn}
+		if getBody := req.GetBody; getBody != nil {
+			req.GetBody = func() (io.ReadCloser, error) {
+				body, err := getBody()
+				if err != nil {
+					return nil, err
+				}
+				return &progressReader{ReadCloser: body, total: total, fn: fn}, nil
+			}
+		}
+		return nil
+	}
+}
+
+// progressReader calls fn after every Read with the running total.
+type progressReader struct {
+	io.ReadCloser
+	sent  atomic.Int64
+	total int64
+	fn    ProgressFunc
+}
+
+func (r *progressReader) Read(p []byte) (int, error) {
+	n, err := r.ReadCloser.Read(p)
+	if n > 0 {
+		r.fn(r.sent.Add(int64(n)), r.total)
+	}
+	return n, err
+}
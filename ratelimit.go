@@ -0,0 +1,26 @@
+package folderfort
+
+import (
+	"errors"
+	"fmt"
+
+	"golang.org/x/time/rate"
+)
+
+// WithRateLimit limits the client to rps requests per second on average,
+// allowing bursts of up to burst requests. Every API call, including each
+// retry, waits for the limiter, so uploads, folder creation and deletes are
+// throttled uniformly. When set, it replaces the WithUploadDelay pause in
+// UploadDirectory and the WithFolderCreationDelay pause. The default is no limit.
+func WithRateLimit(rps float64, burst int) ClientOption {
+	return withDoer(func(d *doerWithToken) error {
+		if rps <= 0 {
+			return fmt.Errorf("rate limit must be positive, got %v", rps)
+		}
+		if burst <= 0 {
+			return errors.New("burst must be positive")
+		}
+		d.limiter = rate.NewLimiter(rate.Limit(rps), burst)
+		return nil
+	})
+}
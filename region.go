@@ -0,0 +1,41 @@
+package folderfort
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Region selects the regional FolderFort deployment that holds an account.
+type Region string
+
+// RegionNA is the North American deployment, na.folderfort.com.
+const RegionNA Region = "na"
+
+// regionHosts maps each known Region to the host serving its API.
+// Only deployments with a documented host are listed.
+var regionHosts = map[Region]string{
+	RegionNA: "na.folderfort.com",
+}
+
+// BaseURLForRegion returns the API server URL of region r, such as
+// "https://na.folderfort.com/api/v1" for RegionNA, or "" if r is unknown.
+// Region names are not case sensitive.
+func BaseURLForRegion(r Region) string {
+	host, ok := regionHosts[Region(strings.ToLower(string(r)))]
+	if !ok {
+		return ""
+	}
+	return "https://" + host + DefaultAPIPath
+}
+
+// resolveServer returns the API server URL for server, which is either a
+// URL or the name of a Region.
+func resolveServer(server string) (string, error) {
+	if strings.Contains(server, "://") {
+		return server, nil
+	}
+	if u := BaseURLForRegion(Region(server)); u != "" {
+		return u, nil
+	}
+	return "", fmt.Errorf("server %q is neither a URL nor a known region such as %q", server, RegionNA)
+}
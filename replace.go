@@ -0,0 +1,74 @@
+package folderfort
+
+import (
+	"context"
+	"fmt"
+	"io"
+)
+
+// WithAtomicReplace controls the order in which UploadFile overwrites an
+// existing file. By default the old file is moved to the trash before the new
+// one is uploaded, so a failed upload leaves neither in place. When enabled,
+// the new file is uploaded first and the old one is only moved to the trash
+// once the upload (and any checksum verification) has succeeded; if the
+// server gave the new file a different name to avoid the collision, it is
+// then renamed back. The default is off.
+func WithAtomicReplace(enabled bool) ClientOption {
+	return withDoer(func(d *doerWithToken) error {
+		d.atomicReplace = enabled
+		return nil
+	})
+}
+
+// idStrings formats entry IDs as strings, as expected by DeleteEntries and
+// the parentIds query parameter.
+func idStrings(ids []int64) []string {
+	strIDs := make([]string, 0, len(ids))
+	for _, id := range ids {
+		strIDs = append(strIDs, fmt.Sprintf("%v", id))
+	}
+	return strIDs
+}
+
+// replaceEntries moves the entries old, which the newly uploaded file result
+// replaces, to the trash and then makes sure result is named fileName.
+func (c *Client) replaceEntries(ctx context.Context, result *UploadResult, fileName string, old []int64) error {
+	var ids []int64
+	for _, id := range old {
+		if id != result.ID {
+			ids = append(ids, id)
+		}
+	}
+	if len(ids) > 0 {
+		if err := c.DeleteEntriesByID(ctx, ids); err != nil {
+			return fmt.Errorf("uploaded %q as entry %v but failed to remove the file it replaces: %w", fileName, result.ID, err)
+		}
+	}
+
+	if result.Name == "" || result.Name == fileName {
+		return nil
+	}
+	if err := c.renameEntry(ctx, result.ID, fileName); err != nil {
+		return fmt.Errorf("uploaded %q as %q but failed to rename it: %w", fileName, result.Name, err)
+	}
+	result.Name = fileName
+	return nil
+}
+
+// renameEntry changes the name of the entry entryID to name.
+func (c *Client) renameEntry(ctx context.Context, entryID int64, name string) error {
+	resp, err := c.EntryUpdate(ctx, int(entryID), EntryUpdateJSONRequestBody{Name: &name})
+	if err != nil {
+		return fmt.Errorf("c.EntryUpdate: %w", err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("io.ReadAll: %w", err)
+	}
+
+	if resp.StatusCode != 200 {
+		return fmt.Errorf("failed to rename entry %v: %w", entryID, newAPIError(resp.StatusCode, body))
+	}
+	return checkEnvelope(resp.StatusCode, body)
+}
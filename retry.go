@@ -0,0 +1,164 @@
+package folderfort
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	defaultMaxRetries = 3
+	defaultRetryDelay = 500 * time.Millisecond
+	maxRetryDelay     = 30 * time.Second
+)
+
+// doWithRetry sends req, retrying transient failures with exponential backoff
+// and jitter, up to d.maxRetries times.
+func (d *doerWithToken) doWithRetry(client *http.Client, req *http.Request) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, fmt.Errorf("failed to rewind request body: %w", err)
+			}
+			req.Body = body
+		}
+
+		if d.limiter != nil {
+			if err := d.limiter.Wait(req.Context()); err != nil {
+				return nil, err
+			}
+		}
+		resp, err := client.Do(req)
+		if attempt >= d.maxRetries || !shouldRetry(req, resp, err) {
+			return resp, err
+		}
+
+		wait := d.retryDelay(attempt, resp)
+		attrs := []any{"method", req.Method, "url", req.URL.Redacted(), "attempt", attempt + 1, "wait", wait}
+		if err != nil {
+			attrs = append(attrs, "error", err)
+		} else {
+			attrs = append(attrs, "status", resp.StatusCode)
+		}
+		d.slog.WarnContext(req.Context(), "retrying request", attrs...)
+		if resp != nil {
+			io.Copy(io.Discard, io.LimitReader(resp.Body, 64*1024))
+			resp.Body.Close()
+		}
+		if err := sleepContext(req.Context(), wait); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// shouldRetry reports whether a request that produced resp or err may be sent again.
+// Idempotent requests are retried after network errors and 429 or 5xx responses.
+// Other requests (such as uploads) are only retried after a 429 or 503, which
+// mean the server did not process them, and only if their body can be re-read.
+func shouldRetry(req *http.Request, resp *http.Response, err error) bool {
+	if req.Context().Err() != nil {
+		return false
+	}
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return false
+	}
+
+	if !isIdempotent(req) {
+		return err == nil && (resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusServiceUnavailable)
+	}
+	if err != nil {
+		return !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded)
+	}
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusInternalServerError, http.StatusBadGateway,
+		http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// isIdempotent reports whether sending req more than once has the same effect as sending it once.
+func isIdempotent(req *http.Request) bool {
+	if req.Header.Get(idempotencyKeyHeader) != "" {
+		return true
+	}
+	method := req.Method
+	if m := req.Header.Get("X-HTTP-Method-Override"); m != "" {
+		method = m
+	}
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// retryDelay returns how long to wait before retry number attempt+1,
+// honoring a Retry-After header on resp if present.
+func (d *doerWithToken) retryDelay(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if wait, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			return min(wait, maxRetryDelay)
+		}
+	}
+	if d.retryDelayBase <= 0 {
+		return 0
+	}
+	backoff := maxRetryDelay
+	if attempt < 32 {
+		backoff = min(d.retryDelayBase<<attempt, maxRetryDelay)
+	}
+	// Jitter spreads out the retries of concurrent uploads.
+	return backoff/2 + rand.N(backoff/2+1)
+}
+
+// parseRetryAfter parses a Retry-After header given in seconds or as an HTTP date.
+func parseRetryAfter(v string) (time.Duration, bool) {
+	if v == "" {
+		return 0, false
+	}
+	if secs, err := strconv.Atoi(v); err == nil && secs >= 0 {
+		return time.Duration(secs) * time.Second, true
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		return max(time.Until(t), 0), true
+	}
+	return 0, false
+}
+
+// sleepContext pauses for d or until ctx is done, whichever comes first.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
+
+// WithRetry sets how many times a request that failed with a transient error
+// (a network error, 429 or 5xx) is retried, and the base delay of the
+// exponential backoff between attempts. A Retry-After header from the server
+// takes precedence over the backoff. The default is 3 retries starting at 500ms.
+// A maxRetries of 0 disables retries.
+func WithRetry(maxRetries int, baseDelay time.Duration) ClientOption {
+	return withDoer(func(d *doerWithToken) error {
+		if maxRetries < 0 || baseDelay < 0 {
+			return errors.New("maxRetries and baseDelay must not be negative")
+		}
+		d.maxRetries = maxRetries
+		d.retryDelayBase = baseDelay
+		return nil
+	})
+}
@@ -0,0 +1,88 @@
+package folderfort
+
+import (
+	"context"
+	"fmt"
+	"slices"
+)
+
+// SearchParams selects the entries returned by SearchEntries.
+// The zero value matches every entry on the drive.
+type SearchParams struct {
+	// Query only matches entries whose names contain it.
+	Query string
+	// Type only matches entries of the given type, such as IndexEntryParamsTypeFolder.
+	Type IndexEntryParamsType
+	// FilesOnly excludes folders from the results.
+	FilesOnly bool
+	// ParentIDs only matches direct children of the given folders.
+	// If empty, entries are matched anywhere on the drive.
+	ParentIDs []int64
+	// OrderBy sorts the results by the given property, such as OrderByName.
+	OrderBy IndexEntryParamsOrderBy
+	// OrderDir is the sort direction, OrderAscending or OrderDescending.
+	OrderDir IndexEntryParamsOrderDir
+}
+
+// indexEntryParams converts p to the parameters of an IndexEntry request.
+func (p SearchParams) indexEntryParams() (IndexEntryParams, error) {
+	var params IndexEntryParams
+	if p.Query != "" {
+		params.Query = Ptr(p.Query)
+	}
+	if p.Type != "" {
+		if p.FilesOnly && p.Type == IndexEntryParamsTypeFolder {
+			return params, fmt.Errorf("type %q conflicts with FilesOnly", p.Type)
+		}
+		params.Type = Ptr(p.Type)
+		if err := validateEntryType(params.Type); err != nil {
+			return params, err
+		}
+	}
+	if len(p.ParentIDs) > 0 {
+		params.ParentIds = Ptr(idStrings(p.ParentIDs))
+	}
+	switch p.OrderBy {
+	case "":
+	case OrderByName, OrderByFileSize, OrderByCreatedAt, OrderByUpdatedAt:
+		params.OrderBy = Ptr(p.OrderBy)
+	default:
+		return params, fmt.Errorf("invalid sort property %q", p.OrderBy)
+	}
+	switch p.OrderDir {
+	case "":
+	case OrderAscending, OrderDescending:
+		params.OrderDir = Ptr(p.OrderDir)
+	default:
+		return params, fmt.Errorf("invalid sort direction %q", p.OrderDir)
+	}
+	return params, nil
+}
+
+// SearchEntries returns every entry matching params, following pagination
+// until all pages have been read. Results are returned in the order sent by
+// the server.
+func (c *Client) SearchEntries(ctx context.Context, params SearchParams) ([]Entry, error) {
+	indexParams, err := params.indexEntryParams()
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := c.listEntries(ctx, indexParams)
+	if err != nil {
+		return nil, err
+	}
+
+	// As with listFolder, the API does not reliably honor ParentIds.
+	results := entries[:0]
+	for _, e := range entries {
+		if params.FilesOnly && e.IsFolder() {
+			continue
+		}
+		if len(params.ParentIDs) > 0 && (e.ParentID == nil || !slices.Contains(params.ParentIDs, *e.ParentID)) {
+			continue
+		}
+		results = append(results, e)
+	}
+	return results, nil
+}
@@ -0,0 +1,58 @@
+package folderfort
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+)
+
+// DefaultAPIPath is the path below the host at which FolderFort serves its API.
+const DefaultAPIPath = "/api/v1"
+
+// WithAPIPath sets the path that the server URL passed to
+// NewClientWithAPIToken must end with, for self-hosted instances that serve
+// the API somewhere other than DefaultAPIPath. Use "/" to accept any path.
+func WithAPIPath(apiPath string) ClientOption {
+	return withDoer(func(d *doerWithToken) error {
+		if !strings.HasPrefix(apiPath, "/") {
+			return fmt.Errorf("API path %q must start with '/'", apiPath)
+		}
+		d.apiPath = strings.TrimSuffix(apiPath, "/")
+		return nil
+	})
+}
+
+// validateServerURL checks that server is an https URL (or an http URL of
+// the local host, for testing) whose path ends with apiPath.
+func validateServerURL(server, apiPath string) error {
+	example := "https://na.folderfort.com" + apiPath
+	u, err := url.Parse(server)
+	if err != nil {
+		return fmt.Errorf("invalid server URL %q (expected something like %v): %w", server, example, err)
+	}
+	if u.Host == "" {
+		return fmt.Errorf("server %q has no host; expected something like %v", server, example)
+	}
+
+	switch {
+	case u.Scheme == "https":
+	case u.Scheme == "http" && isLocalHost(u.Hostname()):
+	default:
+		return fmt.Errorf("server %q must use https:// (http:// is only accepted for localhost); expected something like %v", server, example)
+	}
+
+	if p := strings.TrimSuffix(u.Path, "/"); !strings.HasSuffix(p, apiPath) {
+		return fmt.Errorf("server %q must end with %q (see WithAPIPath); expected something like %v", server, apiPath, example)
+	}
+	return nil
+}
+
+// isLocalHost reports whether host names the local machine.
+func isLocalHost(host string) bool {
+	if strings.EqualFold(host, "localhost") {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
@@ -0,0 +1,110 @@
+package folderfort
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"path"
+	"strings"
+)
+
+// parseShareURL splits a public share link such as
+// https://na.folderfort.com/drive/s/abc123 into its API server URL and link hash.
+func parseShareURL(shareURL string) (server, hash string, err error) {
+	u, err := url.Parse(shareURL)
+	if err != nil {
+		return "", "", fmt.Errorf("invalid share URL %q: %w", shareURL, err)
+	}
+	hash = path.Base(strings.TrimSuffix(u.Path, "/"))
+	if u.Scheme == "" || u.Host == "" || hash == "" || hash == "." || hash == "/" {
+		return "", "", fmt.Errorf("invalid share URL %q", shareURL)
+	}
+	return u.Scheme + "://" + u.Host + "/api/v1", hash, nil
+}
+
+// DownloadFromShareLink streams the file behind a public share link into w.
+// No API token is needed; password may be empty if the link is not protected.
+// Links to folders are rejected since they cannot be streamed as a single file.
+func DownloadFromShareLink(ctx context.Context, shareURL, password string, w io.Writer) error {
+	server, hash, err := parseShareURL(shareURL)
+	if err != nil {
+		return err
+	}
+	c, err := NewClient(server)
+	if err != nil {
+		return err
+	}
+
+	var pw *string
+	if password != "" {
+		pw = &password
+	}
+
+	resp, err := c.ShowShareableLink(ctx, hash, &ShowShareableLinkParams{Password: pw})
+	if err != nil {
+		return fmt.Errorf("c.ShowShareableLink: %w", err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("io.ReadAll: %w", err)
+	}
+
+	if resp.StatusCode != 200 {
+		return fmt.Errorf("failed to resolve share link %q: %w", shareURL, newAPIError(resp.StatusCode, body))
+	}
+	if err := checkEnvelope(resp.StatusCode, body); err != nil {
+		return err
+	}
+
+	var linkResp ShareableLinkResponse
+	if err := json.Unmarshal(body, &linkResp); err != nil {
+		return fmt.Errorf("failed to parse response for share link %q: %w\n%s", shareURL, err, body)
+	}
+	if linkResp.Link == nil || linkResp.Link.Entry == nil {
+		return fmt.Errorf("share link %q has no entry", shareURL)
+	}
+	if t := linkResp.Link.Entry.Type; t != nil && *t == FileEntryTypeFolder {
+		return errors.New("share link points to a folder, not a file")
+	}
+
+	dlResp, err := c.DownloadShareableLink(ctx, hash, &DownloadShareableLinkParams{Password: pw})
+	if err != nil {
+		return fmt.Errorf("c.DownloadShareableLink: %w", err)
+	}
+	defer dlResp.Body.Close()
+
+	if dlResp.StatusCode != 200 {
+		body, _ := io.ReadAll(dlResp.Body)
+		return fmt.Errorf("failed to download share link %q: %w", shareURL, newAPIError(dlResp.StatusCode, body))
+	}
+
+	if _, err := c.copyBuffer(w, dlResp.Body); err != nil {
+		return fmt.Errorf("failed to download share link %q: %w", shareURL, err)
+	}
+	return nil
+}
+
+// RevokeShareableLink deletes the shareable link of entry entryID, removing
+// public access to it. The API identifies a link by the entry it shares, so
+// this is the typed counterpart of the generated DeleteShareableLink.
+// A link that does not exist is reported as an *APIError with StatusCode 404.
+func (c *Client) RevokeShareableLink(ctx context.Context, entryID int64) error {
+	resp, err := c.DeleteShareableLink(ctx, entryID)
+	if err != nil {
+		return fmt.Errorf("c.DeleteShareableLink: %w", err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("io.ReadAll: %w", err)
+	}
+
+	if resp.StatusCode != 200 && resp.StatusCode != 204 {
+		return fmt.Errorf("failed to revoke shareable link of entry %v: %w", entryID, newAPIError(resp.StatusCode, body))
+	}
+	return checkEnvelope(resp.StatusCode, body)
+}
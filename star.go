@@ -0,0 +1,50 @@
+package folderfort
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// StarEntries marks entries as starred (favorites).
+// Use ListEntries with ListStarredOnly to retrieve them.
+func (c *Client) StarEntries(ctx context.Context, entryIDs []int64) error {
+	ids := toIntIDs(entryIDs)
+	resp, err := c.PostFileEntriesStar(ctx, PostFileEntriesStarJSONRequestBody{EntryIds: &ids})
+	if err != nil {
+		return fmt.Errorf("c.PostFileEntriesStar: %w", err)
+	}
+	return checkStarResponse(resp, "star", entryIDs)
+}
+
+// UnstarEntries removes the star from entries.
+func (c *Client) UnstarEntries(ctx context.Context, entryIDs []int64) error {
+	ids := toIntIDs(entryIDs)
+	resp, err := c.PostFileEntriesUnstar(ctx, PostFileEntriesUnstarJSONRequestBody{EntryIds: &ids})
+	if err != nil {
+		return fmt.Errorf("c.PostFileEntriesUnstar: %w", err)
+	}
+	return checkStarResponse(resp, "unstar", entryIDs)
+}
+
+func toIntIDs(entryIDs []int64) []int {
+	ids := make([]int, 0, len(entryIDs))
+	for _, id := range entryIDs {
+		ids = append(ids, int(id))
+	}
+	return ids
+}
+
+func checkStarResponse(resp *http.Response, action string, entryIDs []int64) error {
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("io.ReadAll: %w", err)
+	}
+
+	if resp.StatusCode != 200 {
+		return fmt.Errorf("failed to %v entries %+v: %w", action, entryIDs, newAPIError(resp.StatusCode, body))
+	}
+	return checkEnvelope(resp.StatusCode, body)
+}
@@ -0,0 +1,57 @@
+package folderfort
+
+// Stats summarizes the work done by UploadDirectoryWithOptions or
+// DownloadDirectoryWithOptions.
+type Stats struct {
+	// IDs maps the path of every transferred file and folder, relative to the
+	// top-level directory and using forward slashes, to its remote entry ID.
+	IDs map[string]int64
+
+	// Skipped lists every local path that was deliberately not uploaded.
+	Skipped []SkipEvent
+
+	// Failed lists every file that failed to upload when
+	// UploadOptions.ContinueOnError is set.
+	Failed []FailedUpload
+}
+
+// SkipReason explains why a local path was not uploaded.
+type SkipReason int
+
+const (
+	// SkippedExcluded means the path matched an exclusion rule.
+	SkippedExcluded SkipReason = iota + 1
+	// SkippedTooLarge means the file exceeded the upload size limit.
+	SkippedTooLarge
+	// SkippedUnchanged means the file already exists unchanged on FolderFort.
+	SkippedUnchanged
+	// SkippedSymlink means the path is a symbolic link.
+	SkippedSymlink
+)
+
+func (r SkipReason) String() string {
+	switch r {
+	case SkippedExcluded:
+		return "excluded"
+	case SkippedTooLarge:
+		return "too large"
+	case SkippedUnchanged:
+		return "unchanged"
+	case SkippedSymlink:
+		return "symlink"
+	default:
+		return "unknown"
+	}
+}
+
+// SkipEvent records a local path that was not uploaded and why.
+type SkipEvent struct {
+	// Path is relative to the uploaded directory and uses forward slashes.
+	Path   string
+	Reason SkipReason
+}
+
+// skip records that path was skipped for the given reason.
+func (s *Stats) skip(path string, reason SkipReason) {
+	s.Skipped = append(s.Skipped, SkipEvent{Path: path, Reason: reason})
+}
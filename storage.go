@@ -0,0 +1,78 @@
+package folderfort
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// ErrInsufficientQuota is returned by UploadDirectoryWithOptions when
+// UploadOptions.CheckQuota is set and the upload would not fit in the
+// remaining storage.
+var ErrInsufficientQuota = errors.New("insufficient storage quota")
+
+// StorageInfo describes the authenticated user's storage quota.
+type StorageInfo struct {
+	// Used is the number of bytes currently stored.
+	Used int64 `json:"used"`
+	// Total is the number of bytes the user may store, or 0 if unlimited.
+	Total int64 `json:"available"`
+}
+
+// Available returns the number of bytes that can still be stored, or -1 if
+// the quota is unlimited. It is 0 if the user is at or over quota.
+func (s *StorageInfo) Available() int64 {
+	if s.Total == 0 {
+		return -1
+	}
+	return max(s.Total-s.Used, 0)
+}
+
+// StorageSummary returns the authenticated user's storage usage and quota.
+func (c *Client) StorageSummary(ctx context.Context) (*StorageInfo, error) {
+	resp, err := c.SpaceUsage(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("c.SpaceUsage: %w", err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("io.ReadAll: %w", err)
+	}
+
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("failed to get space usage: %w", newAPIError(resp.StatusCode, body))
+	}
+	if err := checkEnvelope(resp.StatusCode, body); err != nil {
+		return nil, err
+	}
+
+	var info StorageInfo
+	if err := json.Unmarshal(body, &info); err != nil {
+		return nil, fmt.Errorf("failed to parse space usage response: %w\n%s", err, body)
+	}
+	return &info, nil
+}
+
+// checkQuota returns ErrInsufficientQuota if the files that
+// UploadDirectoryWithOptions would upload from dir do not fit in the
+// remaining storage.
+func (c *Client) checkQuota(ctx context.Context, dir string, opts *UploadOptions) error {
+	plan, err := c.PlanUpload(dir, opts)
+	if err != nil {
+		return err
+	}
+	info, err := c.StorageSummary(ctx)
+	if err != nil {
+		return err
+	}
+
+	avail := info.Available()
+	if avail < 0 || plan.Bytes <= avail {
+		return nil
+	}
+	return fmt.Errorf("%w: uploading %v needs %v bytes but only %v are available (short by %v bytes)",
+		ErrInsufficientQuota, dir, plan.Bytes, avail, plan.Bytes-avail)
+}
@@ -0,0 +1,118 @@
+package folderfort
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"golang.org/x/oauth2"
+)
+
+// NewClientWithTokenSource is like NewClientWithAPIToken but authorizes each
+// request with a token obtained from ts, such as the oauth2.Config.TokenSource
+// of an OAuth integration. A token is requested for every request, so ts is
+// responsible for caching tokens and refreshing them when they expire (as
+// the token sources of the oauth2 package do); the client never needs to be
+// re-created when tokens rotate.
+// If the server rejects a token with a 401 anyway, for example because it
+// expired in flight, the request is sent once more with a fresh token (see
+// TokenRefresher); if that fails too, the original 401 is reported as an
+// *APIError.
+func NewClientWithTokenSource(server string, ts oauth2.TokenSource, debug bool, opts ...ClientOption) (*Client, error) {
+	if server == "" || ts == nil {
+		return nil, errors.New("missing server or token source")
+	}
+	d := newDoerWithToken("", debug)
+	d.tokenSource = ts
+	return newClientWithDoer(server, d, opts)
+}
+
+// authorize sets the Authorization header of req from the client's token
+// source, or else its static API token.
+func (d *doerWithToken) authorize(req *http.Request) error {
+	if d.tokenSource == nil {
+		req.Header.Set("Authorization", "Bearer "+d.apiToken)
+		return nil
+	}
+	token, err := d.tokenSource.Token()
+	if err != nil {
+		return fmt.Errorf("failed to get token: %w", err)
+	}
+	token.SetAuthHeader(req)
+	return nil
+}
+
+// TokenRefresher is implemented by token sources that can discard a cached
+// token and fetch a new one on demand. When the server rejects a token from
+// such a source, the client calls RefreshToken before retrying; other
+// sources are simply asked for a token again, which only helps if they
+// already consider the rejected one expired.
+type TokenRefresher interface {
+	oauth2.TokenSource
+	RefreshToken() (*oauth2.Token, error)
+}
+
+// refreshToken returns a token from d.tokenSource to replace the rejected
+// token old, or nil if no different token is available.
+func (d *doerWithToken) refreshToken(old string) *oauth2.Token {
+	var token *oauth2.Token
+	var err error
+	if r, ok := d.tokenSource.(TokenRefresher); ok {
+		token, err = r.RefreshToken()
+	} else {
+		token, err = d.tokenSource.Token()
+	}
+	if err != nil {
+		d.logf("failed to refresh token: %v", err)
+		return nil
+	}
+	if token == nil || token.AccessToken == old {
+		return nil
+	}
+	return token
+}
+
+// retryUnauthorized sends req once more with a fresh token if resp is a 401
+// from a client using a token source and req's body can be sent again. If
+// the retry is rejected too, or cannot be made, resp itself is returned.
+func (d *doerWithToken) retryUnauthorized(req *http.Request, resp *http.Response, do func(*http.Client, *http.Request) (*http.Response, error)) (*http.Response, error) {
+	if d.tokenSource == nil || resp.StatusCode != http.StatusUnauthorized {
+		return resp, nil
+	}
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return resp, nil
+	}
+	_, old, _ := strings.Cut(req.Header.Get("Authorization"), " ")
+	token := d.refreshToken(old)
+	if token == nil {
+		return resp, nil
+	}
+
+	// Keep the original response readable in case the retry fails too.
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 64*1024))
+	resp.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("io.ReadAll: %w", err)
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	if req.GetBody != nil {
+		if req.Body, err = req.GetBody(); err != nil {
+			return nil, fmt.Errorf("failed to rewind request body: %w", err)
+		}
+	}
+	token.SetAuthHeader(req)
+	d.slog.WarnContext(req.Context(), "retrying request with a refreshed token", "method", req.Method, "url", req.URL.Redacted())
+	retry, err := do(d.httpClient(), req)
+	if err != nil {
+		return nil, err
+	}
+	if retry.StatusCode == http.StatusUnauthorized {
+		retry.Body.Close()
+		return resp, nil
+	}
+	return retry, nil
+}
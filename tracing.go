@@ -0,0 +1,92 @@
+package folderfort
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracerName is the instrumentation scope of the spans started by the client.
+const tracerName = "github.com/gmlewis/go-folderfort"
+
+// WithTracerProvider wraps every request sent by the client in an
+// OpenTelemetry client span obtained from tp. Each span is a child of any
+// span in the request's context, so that calls nest under the caller's own
+// span, and the trace is propagated to the server with the global
+// propagator (see otel.SetTextMapPropagator). Spans are named after the
+// operation, such as "GET /file-entries/{id}", and record the HTTP method
+// and status code, the entry ID named in the path and the parent folder ID
+// named in the query, if any. Retries of a request share its span.
+// By default no spans are created and tracing adds no overhead.
+func WithTracerProvider(tp trace.TracerProvider) ClientOption {
+	return withDoer(func(d *doerWithToken) error {
+		d.tracer = nil
+		if tp != nil {
+			d.tracer = tp.Tracer(tracerName)
+		}
+		return nil
+	})
+}
+
+// doTraced sends req like doWithRetry, within a span started by d.tracer.
+func (d *doerWithToken) doTraced(client *http.Client, req *http.Request) (*http.Response, error) {
+	method := req.Method
+	if m := req.Header.Get("X-HTTP-Method-Override"); m != "" {
+		method = m
+	}
+	operation, entryID := d.operation(req.URL.Path)
+	attrs := []attribute.KeyValue{
+		attribute.String("folderfort.operation", operation),
+		attribute.String("http.request.method", method),
+		attribute.String("url.full", req.URL.Redacted()),
+	}
+	if entryID != "" {
+		attrs = append(attrs, attribute.String("folderfort.entry_id", entryID))
+	}
+	if parentIDs := req.URL.Query().Get("parentIds"); parentIDs != "" {
+		attrs = append(attrs, attribute.String("folderfort.parent_id", parentIDs))
+	}
+
+	ctx, span := d.tracer.Start(req.Context(), method+" "+operation,
+		trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(attrs...))
+	defer span.End()
+	req = req.WithContext(ctx)
+	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(req.Header))
+
+	resp, err := d.doWithRetry(client, req)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+	span.SetAttributes(attribute.Int("http.response.status_code", resp.StatusCode))
+	if resp.StatusCode >= 400 {
+		span.SetStatus(codes.Error, resp.Status)
+	}
+	return resp, nil
+}
+
+// operation returns the path of a request below the API path with numeric
+// segments replaced by "{id}", along with the first such segment.
+func (d *doerWithToken) operation(urlPath string) (operation, entryID string) {
+	if i := strings.Index(urlPath, d.apiPath); i >= 0 {
+		urlPath = urlPath[i+len(d.apiPath):]
+	}
+	segments := strings.Split(urlPath, "/")
+	for i, s := range segments {
+		if _, err := strconv.ParseInt(s, 10, 64); err != nil {
+			continue
+		}
+		if entryID == "" {
+			entryID = s
+		}
+		segments[i] = "{id}"
+	}
+	return strings.Join(segments, "/"), entryID
+}
@@ -0,0 +1,174 @@
+package folderfort
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/gmlewis/go-httpdebug/httpdebug"
+)
+
+// transport returns the http.RoundTripper used for every request, building it
+// on first use so that it (and its connection pool) is shared by all requests.
+// Unless WithProxy or WithTransport is used, the transport honors the
+// HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment variables.
+func (d *doerWithToken) transport() http.RoundTripper {
+	d.transportOnce.Do(func() {
+		var rt http.RoundTripper = http.DefaultTransport
+		switch {
+		case d.baseClient != nil:
+			if d.baseClient.Transport != nil {
+				rt = d.baseClient.Transport
+			}
+		case d.baseTransport != nil:
+			rt = d.baseTransport
+		default:
+			// Clone the default transport, so that Close can release this
+			// client's connections without affecting anyone else's.
+			t := http.DefaultTransport.(*http.Transport).Clone()
+			if d.dialTimeout > 0 {
+				dialer := &net.Dialer{Timeout: d.dialTimeout, KeepAlive: 30 * time.Second}
+				t.DialContext = dialer.DialContext
+			}
+			if d.tlsConfig != nil || len(d.clientCerts) > 0 {
+				cfg := &tls.Config{}
+				if d.tlsConfig != nil {
+					cfg = d.tlsConfig.Clone()
+				}
+				cfg.Certificates = append(cfg.Certificates, d.clientCerts...)
+				t.TLSClientConfig = cfg
+			}
+			if d.proxyURL != nil {
+				t.Proxy = http.ProxyURL(d.proxyURL)
+			}
+			rt = t
+			d.ownTransport = t
+		}
+		if d.debug {
+			rt = httpdebug.New(httpdebug.WithTransport(rt))
+		}
+		d.rt = rt
+		d.client = &http.Client{Transport: rt}
+		if d.baseClient != nil {
+			client := *d.baseClient
+			client.Transport = rt
+			d.client = &client
+		}
+		if d.timeout > 0 {
+			d.client.Timeout = d.timeout
+		}
+	})
+	return d.rt
+}
+
+// httpClient returns the http.Client used for every request. It is built
+// once, so connections are pooled and kept alive across requests.
+func (d *doerWithToken) httpClient() *http.Client {
+	d.transport()
+	return d.client
+}
+
+// WithTLSConfig sets the TLS configuration used for all requests, for example
+// to trust a private CA or to present a client certificate to an mTLS proxy.
+// It is applied to a clone of http.DefaultTransport, so all other transport
+// settings keep their defaults. It has no effect if WithTransport is also used.
+func WithTLSConfig(cfg *tls.Config) ClientOption {
+	return withDoer(func(d *doerWithToken) error {
+		if cfg == nil {
+			return errors.New("TLS config must not be nil")
+		}
+		d.tlsConfig = cfg
+		return nil
+	})
+}
+
+// WithClientCertificate adds a client certificate to present during the TLS
+// handshake. It may be combined with WithTLSConfig, in which case the
+// certificate is added to a copy of that configuration.
+func WithClientCertificate(cert tls.Certificate) ClientOption {
+	return withDoer(func(d *doerWithToken) error {
+		d.clientCerts = append(d.clientCerts, cert)
+		return nil
+	})
+}
+
+// WithProxy sends all requests through the proxy at proxyURL
+// (e.g. "http://proxy.example.com:3128"), overriding the HTTP_PROXY,
+// HTTPS_PROXY and NO_PROXY environment variables. It has no effect if
+// WithTransport is also used.
+func WithProxy(proxyURL string) ClientOption {
+	return withDoer(func(d *doerWithToken) error {
+		u, err := url.Parse(proxyURL)
+		if err != nil {
+			return fmt.Errorf("invalid proxy URL %q: %w", proxyURL, err)
+		}
+		if u.Scheme == "" || u.Host == "" {
+			return fmt.Errorf("invalid proxy URL %q: missing scheme or host", proxyURL)
+		}
+		d.proxyURL = u
+		return nil
+	})
+}
+
+// WithTransport replaces the transport used for all requests. The caller is
+// then responsible for its TLS and proxy settings, so WithTLSConfig,
+// WithClientCertificate and WithProxy have no effect. The Authorization header is still
+// added to every request, and debug output still wraps the transport.
+func WithTransport(rt http.RoundTripper) ClientOption {
+	return withDoer(func(d *doerWithToken) error {
+		if rt == nil {
+			return errors.New("transport must not be nil")
+		}
+		d.baseTransport = rt
+		return nil
+	})
+}
+
+// WithTimeout limits the total time of each HTTP request, including reading
+// the response body, by setting http.Client.Timeout. Each retry gets its own
+// timeout. Deadlines on the context passed to each call still apply, so for
+// large uploads, prefer a generous timeout or none and use contexts instead.
+// The default is no timeout.
+func WithTimeout(timeout time.Duration) ClientOption {
+	return withDoer(func(d *doerWithToken) error {
+		if timeout < 0 {
+			return fmt.Errorf("timeout must not be negative, got %v", timeout)
+		}
+		d.timeout = timeout
+		return nil
+	})
+}
+
+// WithDialTimeout limits the time spent establishing each connection, independently
+// of WithTimeout, so an unreachable server fails fast without capping how long
+// a large upload may take. It has no effect if WithTransport or
+// WithAuthenticatedHTTPClient is used. The default is 30s.
+func WithDialTimeout(timeout time.Duration) ClientOption {
+	return withDoer(func(d *doerWithToken) error {
+		if timeout <= 0 {
+			return fmt.Errorf("dial timeout must be positive, got %v", timeout)
+		}
+		d.dialTimeout = timeout
+		return nil
+	})
+}
+
+// WithAuthenticatedHTTPClient sends all requests through client, for example
+// to use its timeouts, cookie jar or a transport that pins certificates.
+// Unlike the generated WithHTTPClient, which replaces the request doer
+// entirely, the Authorization header, retries and rate limiting are still
+// applied. WithTransport, WithTLSConfig, WithClientCertificate and WithProxy
+// have no effect when it is used.
+func WithAuthenticatedHTTPClient(client *http.Client) ClientOption {
+	return withDoer(func(d *doerWithToken) error {
+		if client == nil {
+			return errors.New("http.Client must not be nil")
+		}
+		d.baseClient = client
+		return nil
+	})
+}
@@ -0,0 +1,160 @@
+package folderfort
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"time"
+)
+
+// TrashOptions configures TrashOlderThanWithOptions.
+type TrashOptions struct {
+	// DeleteForever permanently deletes entries instead of moving them to the trash.
+	DeleteForever bool
+
+	// DryRun reports the entries that would be deleted without deleting them.
+	DryRun bool
+
+	// Recursive descends into subfolders and deletes old files at any depth.
+	// Folders themselves are never deleted, recursive or not, since a
+	// folder's own timestamp says nothing about the age of its contents.
+	Recursive bool
+}
+
+// TrashOlderThan moves every file in the folder parentID (or the root folder
+// if nil) that was last updated more than age ago to the trash, or deletes
+// it permanently if deleteForever is true. Subfolders are left alone.
+func (c *Client) TrashOlderThan(ctx context.Context, parentID *int64, age time.Duration, deleteForever bool) error {
+	_, err := c.TrashOlderThanWithOptions(ctx, parentID, age, &TrashOptions{DeleteForever: deleteForever})
+	return err
+}
+
+// TrashOlderThanWithOptions is like TrashOlderThan but returns the entries
+// that were deleted (or, for a DryRun, would have been). The entries are
+// deleted in batches as by DeleteEntries: a failed batch does not stop the
+// others, and the error is then a *DeleteError listing the entries that were
+// not deleted, which are left out of the returned entries.
+// A nil opts uses the defaults.
+func (c *Client) TrashOlderThanWithOptions(ctx context.Context, parentID *int64, age time.Duration, opts *TrashOptions) ([]Entry, error) {
+	if opts == nil {
+		opts = &TrashOptions{}
+	}
+
+	cutoff := time.Now().Add(-age)
+	old, err := c.findOlderThan(ctx, parentID, cutoff, opts.Recursive)
+	if err != nil {
+		return nil, err
+	}
+	if opts.DryRun || len(old) == 0 {
+		return old, nil
+	}
+
+	ids := make([]string, 0, len(old))
+	for _, e := range old {
+		ids = append(ids, fmt.Sprintf("%v", e.ID))
+	}
+	err = c.deleteEntries(ctx, ids, opts.DeleteForever)
+	var delErr *DeleteError
+	if !errors.As(err, &delErr) {
+		return old, err
+	}
+
+	failed := delErr.FailedIDs()
+	deleted := old[:0:0]
+	for _, e := range old {
+		if !slices.Contains(failed, fmt.Sprintf("%v", e.ID)) {
+			deleted = append(deleted, e)
+		}
+	}
+	return deleted, err
+}
+
+// findOlderThan lists the folder parentID and returns the files last updated
+// before cutoff, descending into subfolders if recursive is true.
+func (c *Client) findOlderThan(ctx context.Context, parentID *int64, cutoff time.Time, recursive bool) ([]Entry, error) {
+	entries, err := c.listFolder(ctx, parentID)
+	if err != nil {
+		return nil, err
+	}
+
+	var results []Entry
+	for _, e := range entries {
+		if e.IsFolder() {
+			if !recursive {
+				continue
+			}
+			children, err := c.findOlderThan(ctx, Ptr(e.ID), cutoff, recursive)
+			if err != nil {
+				return nil, err
+			}
+			results = append(results, children...)
+			continue
+		}
+		if !e.UpdatedAt.IsZero() && e.UpdatedAt.Before(cutoff) {
+			results = append(results, e)
+		}
+	}
+	return results, nil
+}
+
+// RestoreEntries moves entries by ID out of the trash and back into their
+// original folders. It is the counterpart of DeleteEntries.
+// The API reports success or failure for the request as a whole, not per entry.
+func (c *Client) RestoreEntries(ctx context.Context, entryIDs []int64) error {
+	ids, err := toInt32IDs(entryIDs)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.EntriesRestore(ctx, EntriesRestoreJSONRequestBody{EntryIds: ids})
+	if err != nil {
+		return fmt.Errorf("c.EntriesRestore: %w", err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("io.ReadAll: %w", err)
+	}
+
+	if resp.StatusCode != 200 {
+		return fmt.Errorf("failed to restore entries %+v: %w", entryIDs, newAPIError(resp.StatusCode, body))
+	}
+	if err := checkEnvelope(resp.StatusCode, body); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// EmptyTrash permanently deletes everything in the trash and returns the number
+// of entries purged. Since the API has no single call for this, the trash is
+// listed and its top-level entries are deleted forever in batches; their
+// contents are purged along with them and are not counted separately.
+// As with DeleteEntries, a failed batch does not stop the others; the error
+// is then a *DeleteError and the count excludes the entries not purged.
+func (c *Client) EmptyTrash(ctx context.Context) (int, error) {
+	trashed, err := c.listEntries(ctx, IndexEntryParams{DeletedOnly: Ptr(true)})
+	if err != nil {
+		return 0, err
+	}
+
+	inTrash := map[int64]bool{}
+	for _, e := range trashed {
+		inTrash[e.ID] = true
+	}
+	var ids []string
+	for _, e := range trashed {
+		if e.ParentID == nil || !inTrash[*e.ParentID] {
+			ids = append(ids, fmt.Sprintf("%v", e.ID))
+		}
+	}
+
+	err = c.deleteEntries(ctx, ids, true)
+	var delErr *DeleteError
+	if errors.As(err, &delErr) {
+		return len(ids) - len(delErr.FailedIDs()), err
+	}
+	return len(ids), err
+}
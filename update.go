@@ -0,0 +1,92 @@
+package folderfort
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"unicode/utf8"
+)
+
+// MaxDescriptionLength is the longest entry description, in characters,
+// accepted by the API.
+const MaxDescriptionLength = 150
+
+// ErrDescriptionTooLong is returned by SetEntryDescription for a description
+// longer than MaxDescriptionLength, without contacting the server.
+var ErrDescriptionTooLong = errors.New("description too long")
+
+// SetEntryDescription sets the description of the file or folder entryID,
+// such as to annotate a backup with "nightly db dump 2024-06-01". An empty
+// description clears it. If the API rejects the update, the error wraps an
+// *APIError.
+func (c *Client) SetEntryDescription(ctx context.Context, entryID int64, description string) error {
+	if n := utf8.RuneCountInString(description); n > MaxDescriptionLength {
+		return fmt.Errorf("%w: %v characters, limit is %v", ErrDescriptionTooLong, n, MaxDescriptionLength)
+	}
+
+	resp, err := c.EntryUpdate(ctx, int(entryID), EntryUpdateJSONRequestBody{Description: &description})
+	if err != nil {
+		return fmt.Errorf("c.EntryUpdate: %w", err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("io.ReadAll: %w", err)
+	}
+
+	if resp.StatusCode != 200 {
+		return fmt.Errorf("failed to set description of entry %v: %w", entryID, newAPIError(resp.StatusCode, body))
+	}
+	return checkEnvelope(resp.StatusCode, body)
+}
+
+// UpdateFileContent replaces the contents of the existing file entryID with
+// size bytes read from r. Unlike deleting and re-uploading the file, the
+// entry keeps its ID, name and any shareable links.
+func (c *Client) UpdateFileContent(ctx context.Context, entryID int64, r io.Reader, size int64) error {
+	if size < 0 {
+		return fmt.Errorf("invalid size %v", size)
+	}
+	if maxSize := c.maxUploadSize(ctx); maxSize > 0 && size > maxSize {
+		return fmt.Errorf("%w: entry %v update is larger than %v bytes", ErrFileTooLarge, entryID, maxSize)
+	}
+
+	var requestBody bytes.Buffer
+	writer := multipart.NewWriter(&requestBody)
+	part, err := writer.CreateFormFile("file", fmt.Sprintf("%v", entryID))
+	if err != nil {
+		return err
+	}
+	n, err := c.copyBuffer(part, io.LimitReader(r, size+1))
+	if err != nil {
+		return fmt.Errorf("error copying file content: %w", err)
+	}
+	if n != size {
+		return fmt.Errorf("read %v bytes of content but expected %v", n, size)
+	}
+	if err := writer.Close(); err != nil {
+		return err
+	}
+
+	resp, err := c.UpdateEntryContentWithBody(ctx, entryID, writer.FormDataContentType(), &requestBody)
+	if err != nil {
+		return fmt.Errorf("c.UpdateEntryContentWithBody: %w", err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("io.ReadAll: %w", err)
+	}
+
+	if resp.StatusCode != 200 {
+		return fmt.Errorf("failed to update content of entry %v: %w", entryID, newAPIError(resp.StatusCode, body))
+	}
+	if err := checkEnvelope(resp.StatusCode, body); err != nil {
+		return err
+	}
+
+	return nil
+}
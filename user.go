@@ -0,0 +1,66 @@
+package folderfort
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// UserProfile describes the authenticated user's account.
+type UserProfile struct {
+	// ID is the user's ID.
+	ID int64
+	// Name is the user's display name.
+	Name string
+	// Email is the user's email address.
+	Email string
+	// Plan is the name of the user's subscription plan, if any.
+	Plan string
+	// StorageLimit is the number of bytes the user may store, or 0 if unlimited.
+	StorageLimit int64
+	// MaxFileSize is the largest file the user may upload in bytes, or 0 if unlimited.
+	MaxFileSize int64
+}
+
+// GetUserProfile returns the profile of the authenticated user.
+func (c *Client) GetUserProfile(ctx context.Context) (*UserProfile, error) {
+	resp, err := c.CurrentUser(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("c.CurrentUser: %w", err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("io.ReadAll: %w", err)
+	}
+
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("failed to get user profile: %w", newAPIError(resp.StatusCode, body))
+	}
+	if err := checkEnvelope(resp.StatusCode, body); err != nil {
+		return nil, err
+	}
+
+	var profile struct {
+		User struct {
+			ID          int64  `json:"id"`
+			DisplayName string `json:"display_name"`
+			Email       string `json:"email"`
+		} `json:"user"`
+		Plan           string `json:"plan"`
+		AvailableSpace int64  `json:"available_space"`
+		MaxFileSize    int64  `json:"max_file_size"`
+	}
+	if err := json.Unmarshal(body, &profile); err != nil {
+		return nil, fmt.Errorf("failed to parse user profile response: %w\n%s", err, body)
+	}
+	return &UserProfile{
+		ID:           profile.User.ID,
+		Name:         profile.User.DisplayName,
+		Email:        profile.User.Email,
+		Plan:         profile.Plan,
+		StorageLimit: profile.AvailableSpace,
+		MaxFileSize:  profile.MaxFileSize,
+	}, nil
+}
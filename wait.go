@@ -0,0 +1,62 @@
+package folderfort
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+const (
+	defaultPollInterval = time.Second
+	defaultPollTimeout  = time.Minute
+)
+
+// ErrProcessingFailed is returned by WaitReady when the server reports that
+// it could not process the file (for example, because it failed a virus scan).
+var ErrProcessingFailed = errors.New("file processing failed")
+
+// PollOptions configures WaitReady.
+type PollOptions struct {
+	// Interval is the pause between polls. The default is 1s.
+	Interval time.Duration
+
+	// Timeout is how long to wait before giving up. The default is 1m.
+	Timeout time.Duration
+}
+
+// WaitReady polls the entry entryID until the server reports that it has
+// finished processing it (for example, virus scanning or thumbnail generation),
+// so that it is safe to share. Entries the server does not process are ready
+// immediately. A nil opts uses the defaults.
+func (c *Client) WaitReady(ctx context.Context, entryID int64, opts *PollOptions) error {
+	interval, timeout := defaultPollInterval, defaultPollTimeout
+	if opts != nil && opts.Interval > 0 {
+		interval = opts.Interval
+	}
+	if opts != nil && opts.Timeout > 0 {
+		timeout = opts.Timeout
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	for {
+		e, err := c.GetEntry(ctx, entryID)
+		if err != nil {
+			return err
+		}
+		switch e.ProcessingStatus {
+		case "", "ready":
+			return nil
+		case "failed":
+			return fmt.Errorf("%w: entry %v", ErrProcessingFailed, entryID)
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("entry %v not ready (status %q): %w", entryID, e.ProcessingStatus, ctx.Err())
+		case <-time.After(interval):
+		}
+	}
+}
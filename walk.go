@@ -0,0 +1,58 @@
+package folderfort
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"path"
+)
+
+// WalkRemoteFunc is called by WalkRemote for each entry. relPath is the path
+// of the entry relative to the folder being walked, using forward slashes.
+// Returning fs.SkipDir for a folder skips its contents; returning any other
+// error stops the walk and is returned by WalkRemote.
+type WalkRemoteFunc func(relPath string, e Entry) error
+
+// WalkRemote walks the tree rooted at the folder folderID (or the root folder
+// if nil), calling fn for each file and folder. Folders are visited before
+// their contents.
+func (c *Client) WalkRemote(ctx context.Context, folderID *int64, fn WalkRemoteFunc) error {
+	err := c.walkRemote(ctx, folderID, "", fn)
+	if errors.Is(err, fs.SkipDir) {
+		return nil
+	}
+	return err
+}
+
+func (c *Client) walkRemote(ctx context.Context, folderID *int64, relPath string, fn WalkRemoteFunc) error {
+	entries, err := c.listFolder(ctx, folderID)
+	if err != nil {
+		return err
+	}
+
+	for _, e := range entries {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		itemRelPath := path.Join(relPath, e.Name)
+		err := fn(itemRelPath, e)
+		if !e.IsFolder() {
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		if errors.Is(err, fs.SkipDir) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if err := c.walkRemote(ctx, Ptr(e.ID), itemRelPath, fn); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,17 @@
+package folderfort
+
+import "fmt"
+
+// WithWorkspace makes the client work in the workspace id instead of the
+// personal space: folders are created and files uploaded there, and listings
+// and lookups only see its entries. Use ListWorkspace to list another
+// workspace for a single call.
+func WithWorkspace(id int64) ClientOption {
+	return withDoer(func(d *doerWithToken) error {
+		if id < 0 {
+			return fmt.Errorf("invalid workspace ID %v", id)
+		}
+		d.workspaceID = &id
+		return nil
+	})
+}